@@ -0,0 +1,159 @@
+package v1alpha1
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"code.uber.internal/apis/evictionrequest/v1beta1"
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+)
+
+// ConversionDataAnnotation holds the v1beta1 fields that v1alpha1 cannot represent, so that converting an
+// eviction request to v1alpha1 and back is lossless
+const ConversionDataAnnotation = "evictionrequest.coordination.uber.com/conversion-data"
+
+// conversionData is the content of the ConversionDataAnnotation
+type conversionData struct {
+	NodeRef            *v1beta1.NodeReference `json:"nodeRef,omitempty"`
+	ObservedGeneration int64                  `json:"observedGeneration,omitempty"`
+}
+
+var _ conversion.Convertible = &EvictionRequest{}
+
+// ConvertTo converts this EvictionRequest to the hub version
+func (src *EvictionRequest) ConvertTo(dstRaw conversion.Hub) error {
+	dst, ok := dstRaw.(*v1beta1.EvictionRequest)
+	if !ok {
+		return fmt.Errorf("unsupported hub %T", dstRaw)
+	}
+
+	var data conversionData
+	if value, ok := src.Annotations[ConversionDataAnnotation]; ok {
+		if err := json.Unmarshal([]byte(value), &data); err != nil {
+			return fmt.Errorf("invalid %s annotation: %w", ConversionDataAnnotation, err)
+		}
+	}
+	src.ObjectMeta.DeepCopyInto(&dst.ObjectMeta)
+	delete(dst.Annotations, ConversionDataAnnotation)
+	if len(dst.Annotations) == 0 {
+		dst.Annotations = nil
+	}
+
+	spec := src.Spec.DeepCopy()
+	dst.Spec = v1beta1.EvictionRequestSpec{
+		Type: v1beta1.EvictionRequestType(spec.Type),
+		Target: v1beta1.EvictionTarget{
+			PodRef:  (*v1beta1.LocalPodReference)(spec.Target.PodRef),
+			NodeRef: data.NodeRef,
+		},
+		HeartbeatDeadlineSeconds:  spec.HeartbeatDeadlineSeconds,
+		TTLSecondsAfterCompletion: spec.TTLSecondsAfterCompletion,
+	}
+	for _, requester := range spec.Requesters {
+		dst.Spec.Requesters = append(dst.Spec.Requesters, v1beta1.Requester(requester))
+	}
+	for _, interceptor := range spec.Interceptors {
+		dst.Spec.Interceptors = append(dst.Spec.Interceptors, v1beta1.Interceptor(interceptor))
+	}
+
+	status := src.Status.DeepCopy()
+	dst.Status = v1beta1.EvictionRequestStatus{
+		Conditions:                        status.Conditions,
+		Message:                           status.Message,
+		ObservedGeneration:                data.ObservedGeneration,
+		EvictionRequestCancellationPolicy: v1beta1.EvictionRequestCancellationPolicy(status.EvictionRequestCancellationPolicy),
+		PodEvictionStatus:                 (*v1beta1.PodEvictionStatus)(status.PodEvictionStatus),
+	}
+	if status.ActiveInterceptorClass != nil || status.ActiveInterceptorCompleted || status.HeartbeatTime != nil || status.ExpectedInterceptorFinishTime != nil {
+		dst.Status.ActiveInterceptor = &v1beta1.ActiveInterceptorStatus{
+			Completed:          status.ActiveInterceptorCompleted,
+			HeartbeatTime:      status.HeartbeatTime,
+			ExpectedFinishTime: status.ExpectedInterceptorFinishTime,
+		}
+		if status.ActiveInterceptorClass != nil {
+			dst.Status.ActiveInterceptor.InterceptorClass = *status.ActiveInterceptorClass
+		}
+	}
+	for _, entry := range status.InterceptorHistory {
+		dst.Status.InterceptorHistory = append(dst.Status.InterceptorHistory, v1beta1.InterceptorHistoryEntry{
+			InterceptorClass:       entry.InterceptorClass,
+			SelectionTime:          entry.SelectionTime,
+			FirstHeartbeatTime:     entry.FirstHeartbeatTime,
+			LastHeartbeatTime:      entry.LastHeartbeatTime,
+			ExpectedFinishTime:     entry.ExpectedFinishTime,
+			CompletionTime:         entry.CompletionTime,
+			Reason:                 v1beta1.InterceptorCompletionReason(entry.Reason),
+			FinishTimeErrorSeconds: entry.FinishTimeErrorSeconds,
+		})
+	}
+	return nil
+}
+
+// ConvertFrom converts from the hub version to this EvictionRequest
+func (dst *EvictionRequest) ConvertFrom(srcRaw conversion.Hub) error {
+	src, ok := srcRaw.(*v1beta1.EvictionRequest)
+	if !ok {
+		return fmt.Errorf("unsupported hub %T", srcRaw)
+	}
+
+	src.ObjectMeta.DeepCopyInto(&dst.ObjectMeta)
+	data := conversionData{
+		NodeRef:            src.Spec.Target.NodeRef.DeepCopy(),
+		ObservedGeneration: src.Status.ObservedGeneration,
+	}
+	if data != (conversionData{}) {
+		value, err := json.Marshal(data)
+		if err != nil {
+			return err
+		}
+		if dst.Annotations == nil {
+			dst.Annotations = map[string]string{}
+		}
+		dst.Annotations[ConversionDataAnnotation] = string(value)
+	} else {
+		delete(dst.Annotations, ConversionDataAnnotation)
+	}
+
+	spec := src.Spec.DeepCopy()
+	dst.Spec = EvictionRequestSpec{
+		Type:                      EvictionRequestType(spec.Type),
+		Target:                    EvictionTarget{PodRef: (*LocalPodReference)(spec.Target.PodRef)},
+		HeartbeatDeadlineSeconds:  spec.HeartbeatDeadlineSeconds,
+		TTLSecondsAfterCompletion: spec.TTLSecondsAfterCompletion,
+	}
+	for _, requester := range spec.Requesters {
+		dst.Spec.Requesters = append(dst.Spec.Requesters, Requester(requester))
+	}
+	for _, interceptor := range spec.Interceptors {
+		dst.Spec.Interceptors = append(dst.Spec.Interceptors, Interceptor(interceptor))
+	}
+
+	status := src.Status.DeepCopy()
+	dst.Status = EvictionRequestStatus{
+		Conditions:                        status.Conditions,
+		Message:                           status.Message,
+		EvictionRequestCancellationPolicy: EvictionRequestCancellationPolicy(status.EvictionRequestCancellationPolicy),
+		PodEvictionStatus:                 (*PodEvictionStatus)(status.PodEvictionStatus),
+	}
+	if active := status.ActiveInterceptor; active != nil {
+		if active.InterceptorClass != "" {
+			dst.Status.ActiveInterceptorClass = &active.InterceptorClass
+		}
+		dst.Status.ActiveInterceptorCompleted = active.Completed
+		dst.Status.HeartbeatTime = active.HeartbeatTime
+		dst.Status.ExpectedInterceptorFinishTime = active.ExpectedFinishTime
+	}
+	for _, entry := range status.InterceptorHistory {
+		dst.Status.InterceptorHistory = append(dst.Status.InterceptorHistory, InterceptorHistoryEntry{
+			InterceptorClass:       entry.InterceptorClass,
+			SelectionTime:          entry.SelectionTime,
+			FirstHeartbeatTime:     entry.FirstHeartbeatTime,
+			LastHeartbeatTime:      entry.LastHeartbeatTime,
+			ExpectedFinishTime:     entry.ExpectedFinishTime,
+			CompletionTime:         entry.CompletionTime,
+			Reason:                 InterceptorCompletionReason(entry.Reason),
+			FinishTimeErrorSeconds: entry.FinishTimeErrorSeconds,
+		})
+	}
+	return nil
+}
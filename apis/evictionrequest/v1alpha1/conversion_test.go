@@ -0,0 +1,96 @@
+package v1alpha1_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"code.uber.internal/apis/evictionrequest/v1alpha1"
+	"code.uber.internal/apis/evictionrequest/v1beta1"
+	"k8s.io/apimachinery/pkg/api/apitesting/fuzzer"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	metafuzzer "k8s.io/apimachinery/pkg/apis/meta/fuzzer"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/apimachinery/pkg/util/diff"
+	"sigs.k8s.io/randfill"
+)
+
+// _fuzzIterations is the number of random eviction requests converted by each round trip test
+const _fuzzIterations = 1000
+
+// normalizingFuncs fill the fields that have several representations of the same value with the one the
+// conversion produces
+func normalizingFuncs(_ serializer.CodecFactory) []interface{} {
+	return []interface{}{
+		func(status *v1alpha1.EvictionRequestStatus, c randfill.Continue) {
+			c.FillNoCustom(status)
+			// The active interceptor class of v1beta1 is not a pointer
+			if status.ActiveInterceptorClass != nil && *status.ActiveInterceptorClass == "" {
+				status.ActiveInterceptorClass = nil
+			}
+		},
+		func(status *v1beta1.EvictionRequestStatus, c randfill.Continue) {
+			c.FillNoCustom(status)
+			// v1alpha1 has no active interceptor status, only its fields
+			if status.ActiveInterceptor != nil && *status.ActiveInterceptor == (v1beta1.ActiveInterceptorStatus{}) {
+				status.ActiveInterceptor = nil
+			}
+		},
+	}
+}
+
+func newFuzzer(t *testing.T) *randfill.Filler {
+	t.Helper()
+
+	scheme := runtime.NewScheme()
+	if err := v1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	if err := v1beta1.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	seed := rand.Int63()
+	t.Logf("fuzzer seed: %d", seed)
+	funcs := fuzzer.MergeFuzzerFuncs(metafuzzer.Funcs, normalizingFuncs)
+	return fuzzer.FuzzerFor(funcs, rand.NewSource(seed), serializer.NewCodecFactory(scheme))
+}
+
+func TestConversionRoundTripFromV1alpha1(t *testing.T) {
+	f := newFuzzer(t)
+	for range _fuzzIterations {
+		original := &v1alpha1.EvictionRequest{}
+		f.Fill(original)
+
+		hub := &v1beta1.EvictionRequest{}
+		if err := original.DeepCopy().ConvertTo(hub); err != nil {
+			t.Fatalf("ConvertTo() error = %v", err)
+		}
+		got := &v1alpha1.EvictionRequest{}
+		if err := got.ConvertFrom(hub); err != nil {
+			t.Fatalf("ConvertFrom() error = %v", err)
+		}
+		if !apiequality.Semantic.DeepEqual(original, got) {
+			t.Fatalf("v1alpha1 -> v1beta1 -> v1alpha1 changed the eviction request:\n%s", diff.Diff(original, got))
+		}
+	}
+}
+
+func TestConversionRoundTripFromV1beta1(t *testing.T) {
+	f := newFuzzer(t)
+	for range _fuzzIterations {
+		original := &v1beta1.EvictionRequest{}
+		f.Fill(original)
+
+		spoke := &v1alpha1.EvictionRequest{}
+		if err := spoke.ConvertFrom(original.DeepCopy()); err != nil {
+			t.Fatalf("ConvertFrom() error = %v", err)
+		}
+		got := &v1beta1.EvictionRequest{}
+		if err := spoke.ConvertTo(got); err != nil {
+			t.Fatalf("ConvertTo() error = %v", err)
+		}
+		if !apiequality.Semantic.DeepEqual(original, got) {
+			t.Fatalf("v1beta1 -> v1alpha1 -> v1beta1 changed the eviction request:\n%s", diff.Diff(original, got))
+		}
+	}
+}
@@ -0,0 +1,126 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// SubjectKind is the kind of identity a Subject refers to.
+// +enum
+type SubjectKind string
+
+const (
+	// UserSubject matches the username of the request.
+	UserSubject SubjectKind = "User"
+	// GroupSubject matches one of the groups of the request.
+	GroupSubject SubjectKind = "Group"
+	// ServiceAccountSubject matches the username of a service account (system:serviceaccount:<namespace>:<name>).
+	ServiceAccountSubject SubjectKind = "ServiceAccount"
+)
+
+// Subject is an identity authorized by an EvictionAuthorizationPolicy.
+// +k8s:deepcopy-gen=true
+// +kubebuilder:validation:XValidation:rule="(self.kind == 'ServiceAccount') == has(self.__namespace__)",message="namespace is required for service accounts and forbidden otherwise"
+type Subject struct {
+	// Kind is the kind of identity.
+	// This field is required.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Enum=User;Group;ServiceAccount
+	Kind SubjectKind `json:"kind"`
+
+	// Name is the name of the user, group or service account.
+	// This field is required.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// Namespace is the namespace of the service account. Required for service accounts, forbidden otherwise.
+	// +kubebuilder:validation:Optional
+	Namespace string `json:"namespace,omitempty"`
+}
+
+// RequesterAuthorization restricts a requester name to a set of identities.
+// +k8s:deepcopy-gen=true
+type RequesterAuthorization struct {
+	// Name is the name of the requester in .spec.requesters of eviction requests.
+	// This field is required.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// Subjects are the identities that may add the requester to or remove it from an eviction request.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MaxItems=100
+	// +listType=atomic
+	Subjects []Subject `json:"subjects,omitempty"`
+}
+
+// InterceptorClassAuthorization restricts an interceptor class to a set of identities.
+// +k8s:deepcopy-gen=true
+type InterceptorClassAuthorization struct {
+	// InterceptorClass is the name of the interceptor class.
+	// This field is required.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	InterceptorClass string `json:"interceptorClass"`
+
+	// Subjects are the identities that may write .status.heartbeatTime, .status.expectedInterceptorFinishTime
+	// and .status.activeInterceptorCompleted while the class is the active interceptor, and hold heartbeat
+	// Leases in its name.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MaxItems=100
+	// +listType=atomic
+	Subjects []Subject `json:"subjects,omitempty"`
+}
+
+// EvictionAuthorizationPolicySpec maps requester names and interceptor classes to the identities allowed to
+// act as them.
+//
+// A requester name or interceptor class listed by any policy may only be used by the subjects of the policies
+// listing it. Names and classes that no policy lists are not restricted. The policies are enforced on admission.
+// +k8s:deepcopy-gen=true
+type EvictionAuthorizationPolicySpec struct {
+	// Requesters restricts requester names to identities.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MaxItems=300
+	// +listType=map
+	// +listMapKey=name
+	Requesters []RequesterAuthorization `json:"requesters,omitempty"`
+
+	// InterceptorClasses restricts interceptor classes to identities.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MaxItems=300
+	// +listType=map
+	// +listMapKey=interceptorClass
+	InterceptorClasses []InterceptorClassAuthorization `json:"interceptorClasses,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Cluster,shortName=evauthz
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+// +genclient
+// +genclient:nonNamespaced
+// +genclient:noStatus
+// +k8s:deepcopy-gen=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// EvictionAuthorizationPolicy restricts who may claim requester names and act as interceptor classes.
+type EvictionAuthorizationPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines the authorization policy.
+	// This field is required.
+	// +required
+	Spec EvictionAuthorizationPolicySpec `json:"spec"`
+}
+
+// +kubebuilder:object:root=true
+// +k8s:deepcopy-gen=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// EvictionAuthorizationPolicyList contains a list of EvictionAuthorizationPolicy
+type EvictionAuthorizationPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []EvictionAuthorizationPolicy `json:"items"`
+}
@@ -0,0 +1,111 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// EvictionPolicySpec defines the defaults and constraints of eviction requests in the selected namespaces.
+//
+// Every policy selecting the namespace of an eviction request applies. Each field is taken from the applying
+// policy with the highest Precedence that sets it; policies with equal precedence are ordered by name.
+// Fields that no applying policy sets keep the behavior of the eviction request API.
+// +k8s:deepcopy-gen=true
+type EvictionPolicySpec struct {
+	// NamespaceSelector selects the namespaces the policy applies to.
+	// An empty or missing selector selects all namespaces.
+	// +kubebuilder:validation:Optional
+	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector,omitempty"`
+
+	// Precedence orders the policies applying to a namespace. Fields of policies with a higher precedence
+	// take priority.
+	// The minimum value is 0 and the maximum value is 1000.
+	// The default value is 0.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=1000
+	// +kubebuilder:default=0
+	Precedence int32 `json:"precedence,omitempty"`
+
+	// DefaultInterceptors are set on eviction requests created without interceptors.
+	// Applied on admission.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MaxItems=300
+	// +listType=map
+	// +listMapKey=interceptorClass
+	DefaultInterceptors []Interceptor `json:"defaultInterceptors,omitempty"`
+
+	// DefaultHeartbeatDeadlineSeconds is set on eviction requests created with the API default of
+	// .spec.heartbeatDeadlineSeconds (1800). Applied on admission.
+	// The minimum value is 600 (10m) and the maximum value is 86400 (24h).
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=600
+	// +kubebuilder:validation:Maximum=86400
+	DefaultHeartbeatDeadlineSeconds *int32 `json:"defaultHeartbeatDeadlineSeconds,omitempty"`
+
+	// MaxHeartbeatDeadlineSeconds is the largest .spec.heartbeatDeadlineSeconds accepted on admission.
+	// The eviction request controller caps the heartbeat deadline of existing eviction requests to it.
+	// The minimum value is 600 (10m) and the maximum value is 86400 (24h).
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=600
+	// +kubebuilder:validation:Maximum=86400
+	MaxHeartbeatDeadlineSeconds *int32 `json:"maxHeartbeatDeadlineSeconds,omitempty"`
+
+	// AllowForbid controls whether interceptors may set .status.evictionRequestCancellationPolicy to Forbid.
+	// If false, such updates are rejected on admission and reset to Allow by the eviction request controller.
+	// The default value is true.
+	// +kubebuilder:validation:Optional
+	AllowForbid *bool `json:"allowForbid,omitempty"`
+
+	// AllowedRequesters lists the names of the requesters that may be added to .spec.requesters.
+	// An empty list allows all requesters. Applied on admission.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MaxItems=100
+	// +listType=set
+	AllowedRequesters []string `json:"allowedRequesters,omitempty"`
+
+	// AllowDirectEvictionFallback controls whether the eviction request controller evicts the pod through
+	// the Eviction API when none of the interceptors completed, e.g. because all of them exceeded their
+	// deadline. Eviction requests without interceptors are always evicted directly.
+	// The default value is true.
+	// +kubebuilder:validation:Optional
+	AllowDirectEvictionFallback *bool `json:"allowDirectEvictionFallback,omitempty"`
+
+	// TTLSecondsAfterCompletion is the time after which complete eviction requests are deleted, unless they
+	// set their own .spec.ttlSecondsAfterCompletion.
+	// The minimum value is 0.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=0
+	TTLSecondsAfterCompletion *int32 `json:"ttlSecondsAfterCompletion,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Cluster,shortName=evpol
+// +kubebuilder:printcolumn:name="Precedence",type="integer",JSONPath=".spec.precedence",description="Precedence of the policy"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+// +genclient
+// +genclient:nonNamespaced
+// +genclient:noStatus
+// +k8s:deepcopy-gen=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// EvictionPolicy sets defaults and constraints for the eviction requests of the selected namespaces.
+type EvictionPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines the eviction policy.
+	// This field is required.
+	// +required
+	Spec EvictionPolicySpec `json:"spec"`
+}
+
+// +kubebuilder:object:root=true
+// +k8s:deepcopy-gen=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// EvictionPolicyList contains a list of EvictionPolicy
+type EvictionPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []EvictionPolicy `json:"items"`
+}
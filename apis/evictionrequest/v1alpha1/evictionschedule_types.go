@@ -0,0 +1,95 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ScheduleWindow is a recurring period of time
+// +k8s:deepcopy-gen=true
+type ScheduleWindow struct {
+	// Name identifies the window in conditions and events.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MaxLength=63
+	Name string `json:"name,omitempty"`
+
+	// Schedule is the start of the window in the standard five field cron format (e.g. "0 22 * * 1-5"),
+	// interpreted in the time zone of the schedule.
+	// This field is required.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Schedule string `json:"schedule"`
+
+	// DurationSeconds is how long the window lasts after each start.
+	// The minimum value is 60 and the maximum value is 604800 (7d).
+	// This field is required.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Minimum=60
+	// +kubebuilder:validation:Maximum=604800
+	DurationSeconds int64 `json:"durationSeconds"`
+}
+
+// EvictionScheduleSpec defines when the selected pods may be evicted
+// +k8s:deepcopy-gen=true
+type EvictionScheduleSpec struct {
+	// NamespaceSelector selects the namespaces of the pods the schedule applies to.
+	// An empty or missing selector selects all namespaces.
+	// +kubebuilder:validation:Optional
+	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector,omitempty"`
+
+	// PodSelector selects the pods the schedule applies to.
+	// An empty or missing selector selects all pods.
+	// +kubebuilder:validation:Optional
+	PodSelector *metav1.LabelSelector `json:"podSelector,omitempty"`
+
+	// TimeZone is the IANA name of the time zone of the schedules (e.g. Europe/Amsterdam).
+	// The default value is UTC.
+	// +kubebuilder:validation:Optional
+	TimeZone *string `json:"timeZone,omitempty"`
+
+	// MaintenanceWindows are the only periods in which the selected pods may be evicted.
+	// If empty, the selected pods may be evicted at any time outside of the freeze periods.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MaxItems=50
+	// +listType=atomic
+	MaintenanceWindows []ScheduleWindow `json:"maintenanceWindows,omitempty"`
+
+	// FreezePeriods are periods in which the selected pods must not be evicted, even within a maintenance window.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MaxItems=50
+	// +listType=atomic
+	FreezePeriods []ScheduleWindow `json:"freezePeriods,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Cluster,shortName=evsched
+// +kubebuilder:printcolumn:name="Time Zone",type="string",JSONPath=".spec.timeZone",description="Time zone of the schedules"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+// +genclient
+// +genclient:nonNamespaced
+// +genclient:noStatus
+// +k8s:deepcopy-gen=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// EvictionSchedule restricts the eviction of the selected pods to maintenance windows and freeze periods.
+// The eviction request controller waits before selecting the first interceptor and before evicting the pod
+// until every schedule selecting the pod allows the eviction.
+type EvictionSchedule struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines the eviction schedule.
+	// This field is required.
+	// +required
+	Spec EvictionScheduleSpec `json:"spec"`
+}
+
+// +kubebuilder:object:root=true
+// +k8s:deepcopy-gen=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// EvictionScheduleList contains a list of EvictionSchedule
+type EvictionScheduleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []EvictionSchedule `json:"items"`
+}
@@ -27,4 +27,8 @@ func Resource(resource string) schema.GroupResource {
 
 func init() {
 	SchemeBuilder.Register(&EvictionRequest{}, &EvictionRequestList{})
+	SchemeBuilder.Register(&InterceptorClass{}, &InterceptorClassList{})
+	SchemeBuilder.Register(&EvictionSchedule{}, &EvictionScheduleList{})
+	SchemeBuilder.Register(&EvictionPolicy{}, &EvictionPolicyList{})
+	SchemeBuilder.Register(&EvictionAuthorizationPolicy{}, &EvictionAuthorizationPolicyList{})
 }
@@ -0,0 +1,196 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// CalloutConfig configures an HTTP or gRPC endpoint that is called by the eviction request controller on behalf of
+// the interceptor class, so that the class can be served without running a controller.
+// +k8s:deepcopy-gen=true
+type CalloutConfig struct {
+	// URL receives the EvictionRequest while the interceptor class is active: as a POST for http:// and
+	// https:// URLs, or through the Callout gRPC service for grpc:// (plaintext) and grpcs:// (TLS) URLs.
+	// This field is required.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern=`^(https?|grpcs?)://`
+	URL string `json:"url"`
+
+	// TimeoutSeconds bounds a single call to the endpoint.
+	// The minimum value is 1 and the maximum value is 300.
+	// The default value is 10.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=300
+	// +kubebuilder:default=10
+	TimeoutSeconds *int32 `json:"timeoutSeconds,omitempty"`
+
+	// PollIntervalSeconds is the delay between calls while the endpoint reports that it is in progress.
+	// The minimum value is 1 and the maximum value is 3600.
+	// The default value is 30.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=3600
+	// +kubebuilder:default=30
+	PollIntervalSeconds *int32 `json:"pollIntervalSeconds,omitempty"`
+}
+
+// JobFailurePolicy is what the job interceptor does when the Job of an EvictionRequest fails
+type JobFailurePolicy string
+
+const (
+	// JobFailurePolicyFallThrough completes the interceptor, so the next interceptor is selected or the pod is
+	// evicted
+	JobFailurePolicyFallThrough JobFailurePolicy = "FallThrough"
+	// JobFailurePolicyBlock keeps the interceptor active and heartbeating, which blocks the eviction. Deleting the
+	// failed Job runs it again.
+	JobFailurePolicyBlock JobFailurePolicy = "Block"
+)
+
+// ConfigMapKeyReference references a key of a ConfigMap.
+// +k8s:deepcopy-gen=true
+type ConfigMapKeyReference struct {
+	// Namespace of the ConfigMap.
+	// This field is required.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Namespace string `json:"namespace"`
+
+	// Name of the ConfigMap.
+	// This field is required.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// Key of the ConfigMap data holding the value.
+	// This field is required.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Key string `json:"key"`
+}
+
+// JobConfig configures the Job run by the job interceptor before the target pod of an EvictionRequest is
+// evicted, so that each class served by the job interceptor can run its own Job.
+// +k8s:deepcopy-gen=true
+type JobConfig struct {
+	// TemplateRef references the batch/v1 Job manifest the Job is created from.
+	// The job interceptor needs get access to the ConfigMap.
+	// This field is required.
+	// +kubebuilder:validation:Required
+	TemplateRef ConfigMapKeyReference `json:"templateRef"`
+
+	// FailurePolicy is what the interceptor does when the Job fails. FallThrough completes the interceptor,
+	// Block blocks the eviction until the failed Job is deleted.
+	// The default value is FallThrough.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=FallThrough;Block
+	// +kubebuilder:default=FallThrough
+	FailurePolicy JobFailurePolicy `json:"failurePolicy,omitempty"`
+}
+
+// InterceptorClassSpec defines the desired state of InterceptorClass
+// +k8s:deepcopy-gen=true
+type InterceptorClassSpec struct {
+	// Domain is the DNS domain that owns the interceptor class (e.g. example.com).
+	// Priorities 9900-10099 of an EvictionRequest are reserved for interceptors with the same domain as the
+	// controller interceptor.
+	// This field is required.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Format=hostname
+	Domain string `json:"domain"`
+
+	// DefaultPriority is the priority used when the class is added to an EvictionRequest without one.
+	// Applied on admission to interceptors with a priority of 0, which typed clients send for an unset priority.
+	// The minimum value is 0 and the maximum value is 100000.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100000
+	DefaultPriority *int32 `json:"defaultPriority,omitempty"`
+
+	// DefaultRole is the role used when the class is added to an EvictionRequest without one.
+	// Applied on admission.
+	// +kubebuilder:validation:Optional
+	DefaultRole *string `json:"defaultRole,omitempty"`
+
+	// MaxHeartbeatDeadlineSeconds caps the .spec.heartbeatDeadlineSeconds of an EvictionRequest while
+	// this class is active.
+	// The minimum value is 60 and the maximum value is 86400.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=60
+	// +kubebuilder:validation:Maximum=86400
+	MaxHeartbeatDeadlineSeconds *int32 `json:"maxHeartbeatDeadlineSeconds,omitempty"`
+
+	// LivenessDeadlineSeconds is the maximum amount of time between two updates of .status.heartbeatTime
+	// by an implementation of the class. If it is exceeded, the class is considered to have no live
+	// implementation and is skipped by the eviction request controller.
+	// Classes with a callout are served by the controller and are always live.
+	// The minimum value is 10 and the maximum value is 86400.
+	// The default value is 300 (5m).
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=10
+	// +kubebuilder:validation:Maximum=86400
+	// +kubebuilder:default=300
+	LivenessDeadlineSeconds *int32 `json:"livenessDeadlineSeconds,omitempty"`
+
+	// Callout configures an HTTP or gRPC endpoint serving the class.
+	// +kubebuilder:validation:Optional
+	Callout *CalloutConfig `json:"callout,omitempty"`
+
+	// Job configures the Job run by the job interceptor serving the class.
+	// +kubebuilder:validation:Optional
+	Job *JobConfig `json:"job,omitempty"`
+}
+
+// InterceptorClassStatus represents the most recently observed status of the interceptor class.
+// Populated by the implementations of the class.
+// +k8s:deepcopy-gen=true
+type InterceptorClassStatus struct {
+	// HeartbeatTime is the last time an implementation of the class reported that it is alive.
+	// +kubebuilder:validation:Optional
+	HeartbeatTime *metav1.Time `json:"heartbeatTime,omitempty"`
+
+	// Conditions can be used by implementations to share additional information about the class.
+	// +kubebuilder:validation:Optional
+	// +patchMergeKey=type
+	// +patchStrategy=merge
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,shortName=icls
+// +kubebuilder:printcolumn:name="Domain",type="string",JSONPath=".spec.domain",description="Domain owning the class"
+// +kubebuilder:printcolumn:name="Priority",type="integer",JSONPath=".spec.defaultPriority",description="Default priority"
+// +kubebuilder:printcolumn:name="Heartbeat",type="date",JSONPath=".status.heartbeatTime",description="Last liveness heartbeat"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// InterceptorClass registers an interceptor class. Its name is the class referenced by
+// .spec.interceptors[].interceptorClass of EvictionRequests.
+type InterceptorClass struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines the interceptor class.
+	// This field is required.
+	// +required
+	Spec InterceptorClassSpec `json:"spec"`
+	// Status represents the most recently observed status of the interceptor class.
+	// +optional
+	Status InterceptorClassStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +k8s:deepcopy-gen=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// InterceptorClassList contains a list of InterceptorClass
+type InterceptorClassList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []InterceptorClass `json:"items"`
+}
@@ -45,6 +45,7 @@ type Requester struct {
 
 // EvictionRequestSpec defines the desired state of EvictionRequest
 // +k8s:deepcopy-gen=true
+// +kubebuilder:validation:XValidation:rule="has(self.interceptors) == has(oldSelf.interceptors)",message="interceptors is immutable"
 type EvictionRequestSpec struct {
 	// Valid types are Soft.
 	// The default value is Soft.
@@ -62,11 +63,13 @@ type EvictionRequestSpec struct {
 	// This field is immutable.
 	// +kubebuilder:validation:Required
 	// +kubebuilder:default=Soft
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="type is immutable"
 	Type EvictionRequestType `json:"type"`
 
 	// Target contains a reference to an object (e.g. a pod) that should be evicted.
 	// This field is immutable.
 	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="target is immutable"
 	Target EvictionTarget `json:"target"`
 
 	// At least one requester is required when creating an eviction request.
@@ -97,6 +100,7 @@ type EvictionRequestSpec struct {
 	// This field is immutable.
 	// +kubebuilder:validation:Optional
 	// +kubebuilder:validation:MaxItems=300
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="interceptors is immutable"
 	// +patchMergeKey=interceptorClass
 	// +patchStrategy=merge
 	// +listType=map
@@ -116,7 +120,17 @@ type EvictionRequestSpec struct {
 	// +kubebuilder:validation:Minimum=600
 	// +kubebuilder:validation:Maximum=86400
 	// +kubebuilder:default=1800
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="heartbeatDeadlineSeconds is immutable"
 	HeartbeatDeadlineSeconds *int32 `json:"heartbeatDeadlineSeconds"`
+
+	// TTLSecondsAfterCompletion is the time after which the eviction request is deleted once it is complete
+	// (Complete condition is True). If not set, the TTL of the EvictionPolicy of the namespace applies; without
+	// one, the eviction request is not deleted automatically. Eviction requests that forbid their cancellation
+	// are only deleted once the pod is gone.
+	// The minimum value is 0.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=0
+	TTLSecondsAfterCompletion *int32 `json:"ttlSecondsAfterCompletion,omitempty"`
 }
 
 // LocalPodReference contains enough information to locate the referenced pod inside the same namespace.
@@ -148,7 +162,8 @@ type Interceptor struct {
 	// Priority for this InterceptorClass. Higher priorities are selected first by the eviction
 	// request controller. The interceptor that is the managing controller should set the value of
 	// this field to 10000 to allow both for preemption or fallback registration by other
-	// interceptors.
+	// interceptors. Interceptors with equal priorities are selected in the alphabetical order of
+	// their InterceptorClass.
 	//
 	// Priorities 9900-10099 are reserved for interceptors with a class that has the same parent
 	// domain as the controller interceptor. Duplicate priorities are not allowed in this interval.
@@ -171,6 +186,7 @@ type Interceptor struct {
 // EvictionRequestStatus represents the most recently observed status of the eviction request.
 // Populated by the current interceptor and eviction request controller.
 // +k8s:deepcopy-gen=true
+// +kubebuilder:validation:XValidation:rule="!has(oldSelf.podEvictionStatus) || has(self.podEvictionStatus)",message="podEvictionStatus cannot be removed"
 type EvictionRequestStatus struct {
 	// Conditions can be used by interceptors to share additional information about the eviction
 	// request.
@@ -239,8 +255,79 @@ type EvictionRequestStatus struct {
 	// Pod-specific status that is populated during pod eviction.
 	// +kubebuilder:validation:Optional
 	PodEvictionStatus *PodEvictionStatus `json:"podEvictionStatus,omitempty"`
+
+	// InterceptorHistory records the interceptors selected by the eviction request controller, oldest
+	// first. The last entry describes the active interceptor while it has no CompletionTime.
+	// Only the most recent 20 entries are kept.
+	// This field is managed by the eviction request controller.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MaxItems=20
+	// +listType=atomic
+	InterceptorHistory []InterceptorHistoryEntry `json:"interceptorHistory,omitempty"`
+}
+
+// InterceptorHistoryEntry records how an interceptor processed the eviction request.
+// +k8s:deepcopy-gen=true
+type InterceptorHistoryEntry struct {
+	// InterceptorClass of the interceptor.
+	// This field is required.
+	// +kubebuilder:validation:Required
+	InterceptorClass string `json:"interceptorClass"`
+
+	// SelectionTime is the time at which the interceptor became the active interceptor.
+	// This field is required.
+	// +kubebuilder:validation:Required
+	SelectionTime metav1.Time `json:"selectionTime"`
+
+	// FirstHeartbeatTime is the first heartbeat observed from the interceptor, i.e. when it adopted
+	// the eviction request.
+	// +kubebuilder:validation:Optional
+	FirstHeartbeatTime *metav1.Time `json:"firstHeartbeatTime,omitempty"`
+
+	// LastHeartbeatTime is the last heartbeat observed from the interceptor.
+	// +kubebuilder:validation:Optional
+	LastHeartbeatTime *metav1.Time `json:"lastHeartbeatTime,omitempty"`
+
+	// ExpectedFinishTime is the last expected finish time reported by the interceptor.
+	// +kubebuilder:validation:Optional
+	ExpectedFinishTime *metav1.Time `json:"expectedFinishTime,omitempty"`
+
+	// CompletionTime is the time at which the interceptor stopped being the active interceptor.
+	// +kubebuilder:validation:Optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+
+	// Reason why the interceptor stopped being the active interceptor.
+	// +kubebuilder:validation:Optional
+	Reason InterceptorCompletionReason `json:"reason,omitempty"`
+
+	// FinishTimeErrorSeconds is the difference between the CompletionTime and the ExpectedFinishTime.
+	// Positive values mean that the interceptor finished later than it expected.
+	// +kubebuilder:validation:Optional
+	FinishTimeErrorSeconds *int64 `json:"finishTimeErrorSeconds,omitempty"`
 }
 
+// InterceptorCompletionReason is the reason why an interceptor stopped being the active interceptor.
+// +enum
+type InterceptorCompletionReason string
+
+const (
+	// InterceptorCompleted means that the interceptor set ActiveInterceptorCompleted.
+	InterceptorCompleted InterceptorCompletionReason = "Completed"
+	// InterceptorDeadlineExceeded means that the interceptor did not report within the heartbeat deadline.
+	InterceptorDeadlineExceeded InterceptorCompletionReason = "DeadlineExceeded"
+	// InterceptorFinishTimeExceeded means that the interceptor took longer than its expected finish time
+	// allowed, including the grace configured in the eviction request controller.
+	InterceptorFinishTimeExceeded InterceptorCompletionReason = "FinishTimeExceeded"
+	// InterceptorNotLive means that the interceptor class had no live implementation and was skipped.
+	InterceptorNotLive InterceptorCompletionReason = "NotLive"
+	// InterceptorNotFound means that the active interceptor class was replaced by one that is not part of
+	// .spec.interceptors.
+	InterceptorNotFound InterceptorCompletionReason = "NotFound"
+	// InterceptorRefused means that the interceptor declined to handle the eviction request. A refusal does
+	// not count as a completion when deciding whether the pod may be evicted directly.
+	InterceptorRefused InterceptorCompletionReason = "Refused"
+)
+
 // EvictionRequestConditionType is a valid value for EvictionRequestCondition.Type
 type EvictionRequestConditionType string
 
@@ -252,6 +339,11 @@ const (
 	EvictionRequestComplete EvictionRequestConditionType = "Complete"
 )
 
+// RetargetByNameAnnotation opts an eviction request into name-based semantics when set to "true": if its target
+// pod is replaced by a pod with the same name, e.g. by a StatefulSet, the eviction request controller creates an
+// eviction request for the new pod with the same spec before completing this one.
+const RetargetByNameAnnotation = "evictionrequest.coordination.uber.com/retarget-by-name"
+
 // EvictionRequestCancellationPolicy defines the cancellation policy for eviction requests.
 // +enum
 type EvictionRequestCancellationPolicy string
@@ -277,12 +369,14 @@ type PodEvictionStatus struct {
 	// +kubebuilder:validation:Required
 	// +kubebuilder:validation:Minimum=0
 	// +kubebuilder:default=0
+	// +kubebuilder:validation:XValidation:rule="self >= oldSelf",message="failedAPIEvictionCounter can only increase"
 	FailedAPIEvictionCounter int32 `json:"failedAPIEvictionCounter"`
 }
 
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
 // +kubebuilder:resource:scope=Namespaced,shortName=evreq
+// +kubebuilder:storageversion
 // +kubebuilder:printcolumn:name="Pod",type="string",JSONPath=".spec.target.podRef.name",description="Target pod for eviction"
 // +kubebuilder:printcolumn:name="ActiveInterceptor",type="string",JSONPath=".status.activeInterceptorClass",description="Current active interceptor"
 // +kubebuilder:printcolumn:name="Heartbeat",type="date",JSONPath=".status.heartbeatTime",description="Last heartbeat"
@@ -0,0 +1,138 @@
+package v1alpha1_test
+
+import (
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"code.uber.internal/apis/evictionrequest/v1alpha1"
+	"code.uber.internal/pkg/testutil/crdvalidation"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+// _crd is the manifest generated from the markers of the API types
+var _crd = filepath.Join("..", "..", "..", "config", "crd", "bases", "evictionrequest.coordination.uber.com_evictionrequests.yaml")
+
+func newEvictionRequest() *v1alpha1.EvictionRequest {
+	return &v1alpha1.EvictionRequest{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "pod"},
+		Spec: v1alpha1.EvictionRequestSpec{
+			Type:                     v1alpha1.Soft,
+			Target:                   v1alpha1.EvictionTarget{PodRef: &v1alpha1.LocalPodReference{Name: "pod", UID: "pod-uid"}},
+			Requesters:               []v1alpha1.Requester{{Name: "requester.example.com"}},
+			Interceptors:             []v1alpha1.Interceptor{{InterceptorClass: "interceptor.example.com", Priority: 100}},
+			HeartbeatDeadlineSeconds: ptr.To[int32](600),
+		},
+		Status: v1alpha1.EvictionRequestStatus{
+			PodEvictionStatus: &v1alpha1.PodEvictionStatus{FailedAPIEvictionCounter: 2},
+		},
+	}
+}
+
+func TestTransitionRules(t *testing.T) {
+	validator, err := crdvalidation.New(_crd, "v1alpha1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tc := range []struct {
+		name           string
+		update         func(evictionRequest *v1alpha1.EvictionRequest)
+		expectedErrors []string
+	}{
+		{name: "unchanged", update: func(*v1alpha1.EvictionRequest) {}},
+		{
+			name: "requesters",
+			update: func(evictionRequest *v1alpha1.EvictionRequest) {
+				evictionRequest.Spec.Requesters = nil
+			},
+		},
+		{
+			name: "type",
+			update: func(evictionRequest *v1alpha1.EvictionRequest) {
+				evictionRequest.Spec.Type = "Hard"
+			},
+			expectedErrors: []string{".spec.type: type is immutable"},
+		},
+		{
+			name: "target",
+			update: func(evictionRequest *v1alpha1.EvictionRequest) {
+				evictionRequest.Spec.Target.PodRef.UID = "replacement-uid"
+			},
+			expectedErrors: []string{".spec.target: target is immutable"},
+		},
+		{
+			name: "interceptors",
+			update: func(evictionRequest *v1alpha1.EvictionRequest) {
+				evictionRequest.Spec.Interceptors[0].Priority = 200
+			},
+			expectedErrors: []string{".spec.interceptors: interceptors is immutable"},
+		},
+		{
+			name: "interceptors removed",
+			update: func(evictionRequest *v1alpha1.EvictionRequest) {
+				evictionRequest.Spec.Interceptors = nil
+			},
+			expectedErrors: []string{".spec: interceptors is immutable"},
+		},
+		{
+			name: "heartbeat deadline",
+			update: func(evictionRequest *v1alpha1.EvictionRequest) {
+				evictionRequest.Spec.HeartbeatDeadlineSeconds = ptr.To[int32](1800)
+			},
+			expectedErrors: []string{".spec.heartbeatDeadlineSeconds: heartbeatDeadlineSeconds is immutable"},
+		},
+		{
+			name: "failed eviction counter increased",
+			update: func(evictionRequest *v1alpha1.EvictionRequest) {
+				evictionRequest.Status.PodEvictionStatus.FailedAPIEvictionCounter = 3
+			},
+		},
+		{
+			name: "failed eviction counter decreased",
+			update: func(evictionRequest *v1alpha1.EvictionRequest) {
+				evictionRequest.Status.PodEvictionStatus.FailedAPIEvictionCounter = 0
+			},
+			expectedErrors: []string{
+				".status.podEvictionStatus.failedAPIEvictionCounter: failedAPIEvictionCounter can only increase",
+			},
+		},
+		{
+			name: "pod eviction status removed",
+			update: func(evictionRequest *v1alpha1.EvictionRequest) {
+				evictionRequest.Status.PodEvictionStatus = nil
+			},
+			expectedErrors: []string{".status: podEvictionStatus cannot be removed"},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			oldEvictionRequest := newEvictionRequest()
+			evictionRequest := oldEvictionRequest.DeepCopy()
+			tc.update(evictionRequest)
+
+			errs, err := validator.Validate(oldEvictionRequest, evictionRequest)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(errs, tc.expectedErrors) {
+				t.Errorf("Validate() = %q, expected %q", errs, tc.expectedErrors)
+			}
+		})
+	}
+}
+
+func TestTransitionRulesOnCreate(t *testing.T) {
+	validator, err := crdvalidation.New(_crd, "v1alpha1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	errs, err := validator.Validate(nil, newEvictionRequest())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(errs) != 0 {
+		t.Errorf("Validate() = %q, expected the transition rules to be skipped on creation", errs)
+	}
+}
@@ -6,10 +6,261 @@
 package v1alpha1
 
 import (
+	v1beta1 "code.uber.internal/apis/evictionrequest/v1beta1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CalloutConfig) DeepCopyInto(out *CalloutConfig) {
+	*out = *in
+	if in.TimeoutSeconds != nil {
+		in, out := &in.TimeoutSeconds, &out.TimeoutSeconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.PollIntervalSeconds != nil {
+		in, out := &in.PollIntervalSeconds, &out.PollIntervalSeconds
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CalloutConfig.
+func (in *CalloutConfig) DeepCopy() *CalloutConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(CalloutConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigMapKeyReference) DeepCopyInto(out *ConfigMapKeyReference) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigMapKeyReference.
+func (in *ConfigMapKeyReference) DeepCopy() *ConfigMapKeyReference {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigMapKeyReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EvictionAuthorizationPolicy) DeepCopyInto(out *EvictionAuthorizationPolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EvictionAuthorizationPolicy.
+func (in *EvictionAuthorizationPolicy) DeepCopy() *EvictionAuthorizationPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(EvictionAuthorizationPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *EvictionAuthorizationPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EvictionAuthorizationPolicyList) DeepCopyInto(out *EvictionAuthorizationPolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]EvictionAuthorizationPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EvictionAuthorizationPolicyList.
+func (in *EvictionAuthorizationPolicyList) DeepCopy() *EvictionAuthorizationPolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(EvictionAuthorizationPolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *EvictionAuthorizationPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EvictionAuthorizationPolicySpec) DeepCopyInto(out *EvictionAuthorizationPolicySpec) {
+	*out = *in
+	if in.Requesters != nil {
+		in, out := &in.Requesters, &out.Requesters
+		*out = make([]RequesterAuthorization, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.InterceptorClasses != nil {
+		in, out := &in.InterceptorClasses, &out.InterceptorClasses
+		*out = make([]InterceptorClassAuthorization, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EvictionAuthorizationPolicySpec.
+func (in *EvictionAuthorizationPolicySpec) DeepCopy() *EvictionAuthorizationPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(EvictionAuthorizationPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EvictionPolicy) DeepCopyInto(out *EvictionPolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EvictionPolicy.
+func (in *EvictionPolicy) DeepCopy() *EvictionPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(EvictionPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *EvictionPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EvictionPolicyList) DeepCopyInto(out *EvictionPolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]EvictionPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EvictionPolicyList.
+func (in *EvictionPolicyList) DeepCopy() *EvictionPolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(EvictionPolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *EvictionPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EvictionPolicySpec) DeepCopyInto(out *EvictionPolicySpec) {
+	*out = *in
+	if in.NamespaceSelector != nil {
+		in, out := &in.NamespaceSelector, &out.NamespaceSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DefaultInterceptors != nil {
+		in, out := &in.DefaultInterceptors, &out.DefaultInterceptors
+		*out = make([]Interceptor, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.DefaultHeartbeatDeadlineSeconds != nil {
+		in, out := &in.DefaultHeartbeatDeadlineSeconds, &out.DefaultHeartbeatDeadlineSeconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxHeartbeatDeadlineSeconds != nil {
+		in, out := &in.MaxHeartbeatDeadlineSeconds, &out.MaxHeartbeatDeadlineSeconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.AllowForbid != nil {
+		in, out := &in.AllowForbid, &out.AllowForbid
+		*out = new(bool)
+		**out = **in
+	}
+	if in.AllowedRequesters != nil {
+		in, out := &in.AllowedRequesters, &out.AllowedRequesters
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AllowDirectEvictionFallback != nil {
+		in, out := &in.AllowDirectEvictionFallback, &out.AllowDirectEvictionFallback
+		*out = new(bool)
+		**out = **in
+	}
+	if in.TTLSecondsAfterCompletion != nil {
+		in, out := &in.TTLSecondsAfterCompletion, &out.TTLSecondsAfterCompletion
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EvictionPolicySpec.
+func (in *EvictionPolicySpec) DeepCopy() *EvictionPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(EvictionPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EvictionRequest) DeepCopyInto(out *EvictionRequest) {
 	*out = *in
@@ -92,6 +343,11 @@ func (in *EvictionRequestSpec) DeepCopyInto(out *EvictionRequestSpec) {
 		*out = new(int32)
 		**out = **in
 	}
+	if in.TTLSecondsAfterCompletion != nil {
+		in, out := &in.TTLSecondsAfterCompletion, &out.TTLSecondsAfterCompletion
+		*out = new(int32)
+		**out = **in
+	}
 	return
 }
 
@@ -133,6 +389,13 @@ func (in *EvictionRequestStatus) DeepCopyInto(out *EvictionRequestStatus) {
 		*out = new(PodEvictionStatus)
 		**out = **in
 	}
+	if in.InterceptorHistory != nil {
+		in, out := &in.InterceptorHistory, &out.InterceptorHistory
+		*out = make([]InterceptorHistoryEntry, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -146,6 +409,107 @@ func (in *EvictionRequestStatus) DeepCopy() *EvictionRequestStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EvictionSchedule) DeepCopyInto(out *EvictionSchedule) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EvictionSchedule.
+func (in *EvictionSchedule) DeepCopy() *EvictionSchedule {
+	if in == nil {
+		return nil
+	}
+	out := new(EvictionSchedule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *EvictionSchedule) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EvictionScheduleList) DeepCopyInto(out *EvictionScheduleList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]EvictionSchedule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EvictionScheduleList.
+func (in *EvictionScheduleList) DeepCopy() *EvictionScheduleList {
+	if in == nil {
+		return nil
+	}
+	out := new(EvictionScheduleList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *EvictionScheduleList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EvictionScheduleSpec) DeepCopyInto(out *EvictionScheduleSpec) {
+	*out = *in
+	if in.NamespaceSelector != nil {
+		in, out := &in.NamespaceSelector, &out.NamespaceSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PodSelector != nil {
+		in, out := &in.PodSelector, &out.PodSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TimeZone != nil {
+		in, out := &in.TimeZone, &out.TimeZone
+		*out = new(string)
+		**out = **in
+	}
+	if in.MaintenanceWindows != nil {
+		in, out := &in.MaintenanceWindows, &out.MaintenanceWindows
+		*out = make([]ScheduleWindow, len(*in))
+		copy(*out, *in)
+	}
+	if in.FreezePeriods != nil {
+		in, out := &in.FreezePeriods, &out.FreezePeriods
+		*out = make([]ScheduleWindow, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EvictionScheduleSpec.
+func (in *EvictionScheduleSpec) DeepCopy() *EvictionScheduleSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(EvictionScheduleSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EvictionTarget) DeepCopyInto(out *EvictionTarget) {
 	*out = *in
@@ -188,6 +552,216 @@ func (in *Interceptor) DeepCopy() *Interceptor {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InterceptorClass) DeepCopyInto(out *InterceptorClass) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterceptorClass.
+func (in *InterceptorClass) DeepCopy() *InterceptorClass {
+	if in == nil {
+		return nil
+	}
+	out := new(InterceptorClass)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *InterceptorClass) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InterceptorClassAuthorization) DeepCopyInto(out *InterceptorClassAuthorization) {
+	*out = *in
+	if in.Subjects != nil {
+		in, out := &in.Subjects, &out.Subjects
+		*out = make([]Subject, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterceptorClassAuthorization.
+func (in *InterceptorClassAuthorization) DeepCopy() *InterceptorClassAuthorization {
+	if in == nil {
+		return nil
+	}
+	out := new(InterceptorClassAuthorization)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InterceptorClassList) DeepCopyInto(out *InterceptorClassList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]InterceptorClass, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterceptorClassList.
+func (in *InterceptorClassList) DeepCopy() *InterceptorClassList {
+	if in == nil {
+		return nil
+	}
+	out := new(InterceptorClassList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *InterceptorClassList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InterceptorClassSpec) DeepCopyInto(out *InterceptorClassSpec) {
+	*out = *in
+	if in.DefaultPriority != nil {
+		in, out := &in.DefaultPriority, &out.DefaultPriority
+		*out = new(int32)
+		**out = **in
+	}
+	if in.DefaultRole != nil {
+		in, out := &in.DefaultRole, &out.DefaultRole
+		*out = new(string)
+		**out = **in
+	}
+	if in.MaxHeartbeatDeadlineSeconds != nil {
+		in, out := &in.MaxHeartbeatDeadlineSeconds, &out.MaxHeartbeatDeadlineSeconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.LivenessDeadlineSeconds != nil {
+		in, out := &in.LivenessDeadlineSeconds, &out.LivenessDeadlineSeconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Callout != nil {
+		in, out := &in.Callout, &out.Callout
+		*out = new(CalloutConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Job != nil {
+		in, out := &in.Job, &out.Job
+		*out = new(JobConfig)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterceptorClassSpec.
+func (in *InterceptorClassSpec) DeepCopy() *InterceptorClassSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(InterceptorClassSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InterceptorClassStatus) DeepCopyInto(out *InterceptorClassStatus) {
+	*out = *in
+	if in.HeartbeatTime != nil {
+		in, out := &in.HeartbeatTime, &out.HeartbeatTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterceptorClassStatus.
+func (in *InterceptorClassStatus) DeepCopy() *InterceptorClassStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(InterceptorClassStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InterceptorHistoryEntry) DeepCopyInto(out *InterceptorHistoryEntry) {
+	*out = *in
+	in.SelectionTime.DeepCopyInto(&out.SelectionTime)
+	if in.FirstHeartbeatTime != nil {
+		in, out := &in.FirstHeartbeatTime, &out.FirstHeartbeatTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LastHeartbeatTime != nil {
+		in, out := &in.LastHeartbeatTime, &out.LastHeartbeatTime
+		*out = (*in).DeepCopy()
+	}
+	if in.ExpectedFinishTime != nil {
+		in, out := &in.ExpectedFinishTime, &out.ExpectedFinishTime
+		*out = (*in).DeepCopy()
+	}
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+	if in.FinishTimeErrorSeconds != nil {
+		in, out := &in.FinishTimeErrorSeconds, &out.FinishTimeErrorSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterceptorHistoryEntry.
+func (in *InterceptorHistoryEntry) DeepCopy() *InterceptorHistoryEntry {
+	if in == nil {
+		return nil
+	}
+	out := new(InterceptorHistoryEntry)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JobConfig) DeepCopyInto(out *JobConfig) {
+	*out = *in
+	out.TemplateRef = in.TemplateRef
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JobConfig.
+func (in *JobConfig) DeepCopy() *JobConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(JobConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LocalPodReference) DeepCopyInto(out *LocalPodReference) {
 	*out = *in
@@ -235,3 +809,77 @@ func (in *Requester) DeepCopy() *Requester {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RequesterAuthorization) DeepCopyInto(out *RequesterAuthorization) {
+	*out = *in
+	if in.Subjects != nil {
+		in, out := &in.Subjects, &out.Subjects
+		*out = make([]Subject, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RequesterAuthorization.
+func (in *RequesterAuthorization) DeepCopy() *RequesterAuthorization {
+	if in == nil {
+		return nil
+	}
+	out := new(RequesterAuthorization)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScheduleWindow) DeepCopyInto(out *ScheduleWindow) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScheduleWindow.
+func (in *ScheduleWindow) DeepCopy() *ScheduleWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(ScheduleWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Subject) DeepCopyInto(out *Subject) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Subject.
+func (in *Subject) DeepCopy() *Subject {
+	if in == nil {
+		return nil
+	}
+	out := new(Subject)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *conversionData) DeepCopyInto(out *conversionData) {
+	*out = *in
+	if in.NodeRef != nil {
+		in, out := &in.NodeRef, &out.NodeRef
+		*out = new(v1beta1.NodeReference)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new conversionData.
+func (in *conversionData) DeepCopy() *conversionData {
+	if in == nil {
+		return nil
+	}
+	out := new(conversionData)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -0,0 +1,4 @@
+package v1beta1
+
+// Hub marks v1beta1 as the version the other versions of EvictionRequest convert through
+func (*EvictionRequest) Hub() {}
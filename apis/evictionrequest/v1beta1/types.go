@@ -0,0 +1,447 @@
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// EvictionRequestType is the type of eviction request.
+// +enum
+type EvictionRequestType string
+
+const (
+	// Soft type attempts to evict the target gracefully.
+	// Each active interceptor is given unlimited time to resolve the eviction request, provided
+	// that it responds periodically (see .spec.heartbeatDeadlineSeconds). This means there is no
+	// deadline for a single interceptor, or for the eviction request as a whole.
+	//
+	// For pod targets, the eviction request controller will call the eviction API endpoint when
+	// there are no more interceptors. This call may not succeed due to PodDisruptionBudgets, which
+	// may block the pod termination (see .status.podEvictionStatus.failedAPIEvictionCounter). A
+	// successful soft eviction request should ideally result in the pod being terminated gracefully.
+	Soft EvictionRequestType = "Soft"
+)
+
+// EvictionTarget contains a reference to an object that should be evicted.
+// Exactly one target (PodRef, NodeRef) is required.
+// +k8s:deepcopy-gen=true
+// +kubebuilder:validation:XValidation:rule="has(self.podRef) != has(self.nodeRef)",message="exactly one of podRef and nodeRef is required"
+type EvictionTarget struct {
+	// PodRef references a pod that is subject to eviction/termination.
+	// This field is immutable.
+	// +kubebuilder:validation:Optional
+	PodRef *LocalPodReference `json:"podRef,omitempty"`
+
+	// NodeRef references a node whose pods are subject to eviction, e.g. to drain it.
+	// The eviction request controller does not process node targets yet.
+	// This field is immutable.
+	// +kubebuilder:validation:Optional
+	NodeRef *NodeReference `json:"nodeRef,omitempty"`
+}
+
+// Requester identifies the entity that is requesting the eviction.
+// +k8s:deepcopy-gen=true
+type Requester struct {
+	// Name must be RFC-1123 DNS subdomain identifying the requester (e.g.
+	// foo.example.com).
+	// Name of the requester.
+	// This field is required.
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+}
+
+// EvictionRequestSpec defines the desired state of EvictionRequest
+// +k8s:deepcopy-gen=true
+// +kubebuilder:validation:XValidation:rule="has(self.interceptors) == has(oldSelf.interceptors)",message="interceptors is immutable"
+type EvictionRequestSpec struct {
+	// Valid types are Soft.
+	// The default value is Soft.
+	//
+	// Soft type attempts to evict the target gracefully.
+	// Each active interceptor is given unlimited time to resolve the eviction request, provided
+	// that it responds periodically (see .spec.heartbeatDeadlineSeconds). This means there is no
+	// deadline for a single interceptor, or for the eviction request as a whole.
+	//
+	// For pod targets, the eviction request controller will call the /evict API endpoint when
+	// there are no more interceptors. This call may not succeed due to PodDisruptionBudgets, which
+	// may block the pod termination (see .status.podEvictionStatus.failedAPIEvictionCounter). A
+	// successful soft eviction request should ideally result in the pod being terminated gracefully.
+	//
+	// This field is immutable.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:default=Soft
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="type is immutable"
+	Type EvictionRequestType `json:"type"`
+
+	// Target contains a reference to an object (e.g. a pod) that should be evicted.
+	// This field is immutable.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="target is immutable"
+	Target EvictionTarget `json:"target"`
+
+	// At least one requester is required when creating an eviction request.
+	// A requester is also required for the eviction request to be processed.
+	// Empty list indicates that the eviction request should be canceled.
+	//
+	// This field cannot be modified if the .status.evictionRequestCancellationPolicy field is
+	// set to `Forbid`.
+	// It also cannot be modified once the eviction request has been completed (Complete condition is
+	// True).
+	// +kubebuilder:validation:Optional
+	// +patchMergeKey=name
+	// +patchStrategy=merge
+	// +listType=map
+	// +listMapKey=name
+	Requesters []Requester `json:"requesters,omitempty" patchStrategy:"merge" patchMergeKey:"name"`
+
+	// Interceptors reference interceptors that respond to this eviction request.
+	// Interceptors should observe and communicate through the EvictionRequest API to help with
+	// the graceful eviction of a target (e.g. termination of a pod).
+	//
+	// This field does not need to be set and is resolved when the EvictionRequest object is created
+	// on admission. It can be populated from multiple sources:
+	// - Pod's .spec.evictionInterceptors
+	//
+	// The maximum length of the interceptors list is 300. The number of interceptors is limited to
+	// 50 in the 9900-10099 interval and to 250 outside of this interval.
+	// This field is immutable.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MaxItems=300
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="interceptors is immutable"
+	// +patchMergeKey=interceptorClass
+	// +patchStrategy=merge
+	// +listType=map
+	// +listMapKey=interceptorClass
+	Interceptors []Interceptor `json:"interceptors,omitempty" patchStrategy:"merge" patchMergeKey:"interceptorClass"`
+
+	// HeartbeatDeadlineSeconds is a maximum amount of time an interceptor should take to report on
+	// an eviction progress by updating the .status.activeInterceptor.heartbeatTime.
+	// If the .status.activeInterceptor.heartbeatTime is not updated within the duration of
+	// HeartbeatDeadlineSeconds, the eviction request is passed over to the next interceptor with the
+	// highest priority. If there is none, the pod is evicted using the Eviction API.
+	//
+	// The minimum value is 600 (10m) and the maximum value is 86400 (24h).
+	// The default value is 1800 (30m).
+	// This field is required and immutable.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Minimum=600
+	// +kubebuilder:validation:Maximum=86400
+	// +kubebuilder:default=1800
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="heartbeatDeadlineSeconds is immutable"
+	HeartbeatDeadlineSeconds *int32 `json:"heartbeatDeadlineSeconds"`
+
+	// TTLSecondsAfterCompletion is the time after which the eviction request is deleted once it is complete
+	// (Complete condition is True). If not set, the TTL of the EvictionPolicy of the namespace applies; without
+	// one, the eviction request is not deleted automatically. Eviction requests that forbid their cancellation
+	// are only deleted once the pod is gone.
+	// The minimum value is 0.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=0
+	TTLSecondsAfterCompletion *int32 `json:"ttlSecondsAfterCompletion,omitempty"`
+}
+
+// LocalPodReference contains enough information to locate the referenced pod inside the same namespace.
+// +k8s:deepcopy-gen=true
+type LocalPodReference struct {
+	// Name of the pod.
+	// This field is required.
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+	// UID of the pod.
+	// This field is required.
+	// +kubebuilder:validation:Required
+	UID string `json:"uid"`
+}
+
+// NodeReference contains enough information to locate the referenced node.
+// +k8s:deepcopy-gen=true
+type NodeReference struct {
+	// Name of the node.
+	// This field is required.
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+	// UID of the node.
+	// This field is required.
+	// +kubebuilder:validation:Required
+	UID string `json:"uid"`
+}
+
+// Interceptor allows you to identify the interceptor responding to the EvictionRequest.
+// Interceptors should observe and communicate through the EvictionRequest API to help with
+// the graceful eviction of a target (e.g. termination of a pod).
+// +k8s:deepcopy-gen=true
+type Interceptor struct {
+	// InterceptorClass must be RFC-1123 DNS subdomain identifying the interceptor (e.g.
+	// bar.example.com).
+	// This field must be unique for each interceptor.
+	// This field is required.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Format=hostname
+	InterceptorClass string `json:"interceptorClass"`
+
+	// Priority for this InterceptorClass. Higher priorities are selected first by the eviction
+	// request controller. The interceptor that is the managing controller should set the value of
+	// this field to 10000 to allow both for preemption or fallback registration by other
+	// interceptors. Interceptors with equal priorities are selected in the alphabetical order of
+	// their InterceptorClass.
+	//
+	// Priorities 9900-10099 are reserved for interceptors with a class that has the same parent
+	// domain as the controller interceptor. Duplicate priorities are not allowed in this interval.
+	//
+	// The number of interceptors is limited to 50 in the 9900-10099 interval and to 250
+	// outside of this interval.
+	// The minimum value is 0 and the maximum value is 100000.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100000
+	Priority int32 `json:"priority"`
+
+	// Role of the interceptor. The "controller" value is reserved for the managing controller of
+	// the pod. The role can send additional signal to other interceptors if they should preempt
+	// this interceptor or not.
+	// +kubebuilder:validation:Optional
+	Role *string `json:"role,omitempty"`
+}
+
+// EvictionRequestStatus represents the most recently observed status of the eviction request.
+// Populated by the current interceptor and eviction request controller.
+// +k8s:deepcopy-gen=true
+// +kubebuilder:validation:XValidation:rule="!has(oldSelf.podEvictionStatus) || has(self.podEvictionStatus)",message="podEvictionStatus cannot be removed"
+type EvictionRequestStatus struct {
+	// Conditions can be used by interceptors to share additional information about the eviction
+	// request.
+	// See EvictionRequestConditionType for eviction request specific conditions.
+	// +kubebuilder:validation:Optional
+	// +patchMergeKey=type
+	// +patchStrategy=merge
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type"`
+
+	// Message is a human readable message indicating details about the eviction request.
+	// This may be an empty string.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MaxLength=32768
+	Message string `json:"message"`
+
+	// ObservedGeneration is the .metadata.generation of the eviction request last processed by the eviction
+	// request controller.
+	// +kubebuilder:validation:Optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// ActiveInterceptor is the interceptor currently processing the eviction request.
+	// This field is managed by Kubernetes. It is cleared once the eviction request has completed.
+	// +kubebuilder:validation:Optional
+	ActiveInterceptor *ActiveInterceptorStatus `json:"activeInterceptor,omitempty"`
+
+	// EvictionRequestCancellationPolicy should be set to Forbid by the interceptor if it is not possible
+	// to cancel (delete) the eviction request.
+	// When this value is Forbid, DELETE requests of this EvictionRequest object will not be accepted
+	// while the pod exists.
+	// This field is not reset by the eviction request controller when selecting an interceptor.
+	// Changes to this field should always be reconciled by the active interceptor.
+	//
+	// Valid policies are Allow and Forbid.
+	// The default value is Allow.
+	//
+	// Allow policy allows cancellation of this eviction request.
+	// The EvictionRequest can be deleted before the Pod is fully terminated.
+	//
+	// Forbid policy forbids cancellation of this eviction request.
+	// The EvictionRequest can't be deleted until the Pod is fully terminated.
+	//
+	// This field is required.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Enum=Allow;Forbid
+	// +kubebuilder:default=Allow
+	EvictionRequestCancellationPolicy EvictionRequestCancellationPolicy `json:"evictionRequestCancellationPolicy"`
+
+	// Pod-specific status that is populated during pod eviction.
+	// +kubebuilder:validation:Optional
+	PodEvictionStatus *PodEvictionStatus `json:"podEvictionStatus,omitempty"`
+
+	// InterceptorHistory records the interceptors selected by the eviction request controller, oldest
+	// first. The last entry describes the active interceptor while it has no CompletionTime.
+	// Only the most recent 20 entries are kept.
+	// This field is managed by the eviction request controller.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MaxItems=20
+	// +listType=atomic
+	InterceptorHistory []InterceptorHistoryEntry `json:"interceptorHistory,omitempty"`
+}
+
+// ActiveInterceptorStatus is the status of the interceptor currently processing the eviction request.
+// +k8s:deepcopy-gen=true
+type ActiveInterceptorStatus struct {
+	// InterceptorClass of the active interceptor. Interceptors of this class can adopt the eviction request by
+	// updating the HeartbeatTime or orphan/complete it by setting Completed to true.
+	// Empty if no interceptor is available.
+	// +kubebuilder:validation:Optional
+	InterceptorClass string `json:"interceptorClass,omitempty"`
+
+	// Completed should be set to true when the active interceptor has fully or partially completed (may result
+	// in pod termination).
+	// This field can also be set to true if no interceptor is available.
+	// If this field is true, there is no additional interceptor available, and the evicted pod is
+	// still running, it will be evicted using the Eviction API.
+	// +kubebuilder:validation:Optional
+	Completed bool `json:"completed,omitempty"`
+
+	// HeartbeatTime is the time at which the eviction process was reported to be in progress by
+	// the interceptor.
+	// Cannot be set to the future time (after taking time skew into account).
+	// +kubebuilder:validation:Optional
+	HeartbeatTime *metav1.Time `json:"heartbeatTime,omitempty"`
+
+	// ExpectedFinishTime is the time at which the eviction process step is expected to end for the
+	// interceptor.
+	// May be empty if no estimate can be made.
+	// +kubebuilder:validation:Optional
+	ExpectedFinishTime *metav1.Time `json:"expectedFinishTime,omitempty"`
+}
+
+// InterceptorHistoryEntry records how an interceptor processed the eviction request.
+// +k8s:deepcopy-gen=true
+type InterceptorHistoryEntry struct {
+	// InterceptorClass of the interceptor.
+	// This field is required.
+	// +kubebuilder:validation:Required
+	InterceptorClass string `json:"interceptorClass"`
+
+	// SelectionTime is the time at which the interceptor became the active interceptor.
+	// This field is required.
+	// +kubebuilder:validation:Required
+	SelectionTime metav1.Time `json:"selectionTime"`
+
+	// FirstHeartbeatTime is the first heartbeat observed from the interceptor, i.e. when it adopted
+	// the eviction request.
+	// +kubebuilder:validation:Optional
+	FirstHeartbeatTime *metav1.Time `json:"firstHeartbeatTime,omitempty"`
+
+	// LastHeartbeatTime is the last heartbeat observed from the interceptor.
+	// +kubebuilder:validation:Optional
+	LastHeartbeatTime *metav1.Time `json:"lastHeartbeatTime,omitempty"`
+
+	// ExpectedFinishTime is the last expected finish time reported by the interceptor.
+	// +kubebuilder:validation:Optional
+	ExpectedFinishTime *metav1.Time `json:"expectedFinishTime,omitempty"`
+
+	// CompletionTime is the time at which the interceptor stopped being the active interceptor.
+	// +kubebuilder:validation:Optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+
+	// Reason why the interceptor stopped being the active interceptor.
+	// +kubebuilder:validation:Optional
+	Reason InterceptorCompletionReason `json:"reason,omitempty"`
+
+	// FinishTimeErrorSeconds is the difference between the CompletionTime and the ExpectedFinishTime.
+	// Positive values mean that the interceptor finished later than it expected.
+	// +kubebuilder:validation:Optional
+	FinishTimeErrorSeconds *int64 `json:"finishTimeErrorSeconds,omitempty"`
+}
+
+// InterceptorCompletionReason is the reason why an interceptor stopped being the active interceptor.
+// +enum
+type InterceptorCompletionReason string
+
+const (
+	// InterceptorCompleted means that the interceptor set .status.activeInterceptor.completed.
+	InterceptorCompleted InterceptorCompletionReason = "Completed"
+	// InterceptorDeadlineExceeded means that the interceptor did not report within the heartbeat deadline.
+	InterceptorDeadlineExceeded InterceptorCompletionReason = "DeadlineExceeded"
+	// InterceptorFinishTimeExceeded means that the interceptor took longer than its expected finish time
+	// allowed, including the grace configured in the eviction request controller.
+	InterceptorFinishTimeExceeded InterceptorCompletionReason = "FinishTimeExceeded"
+	// InterceptorNotLive means that the interceptor class had no live implementation and was skipped.
+	InterceptorNotLive InterceptorCompletionReason = "NotLive"
+	// InterceptorNotFound means that the active interceptor class was replaced by one that is not part of
+	// .spec.interceptors.
+	InterceptorNotFound InterceptorCompletionReason = "NotFound"
+	// InterceptorRefused means that the interceptor declined to handle the eviction request. A refusal does
+	// not count as a completion when deciding whether the pod may be evicted directly.
+	InterceptorRefused InterceptorCompletionReason = "Refused"
+)
+
+// EvictionRequestConditionType is a valid value for EvictionRequestCondition.Type
+type EvictionRequestConditionType string
+
+// These are built-in conditions of an eviction request.
+const (
+	// EvictionRequestComplete means that the eviction request is no longer being processed by any
+	// eviction interceptor. This may be either because the pod has been terminated or deleted, or
+	// because the eviction request has been canceled.
+	EvictionRequestComplete EvictionRequestConditionType = "Complete"
+)
+
+// RetargetByNameAnnotation opts an eviction request into name-based semantics when set to "true": if its target
+// pod is replaced by a pod with the same name, e.g. by a StatefulSet, the eviction request controller creates an
+// eviction request for the new pod with the same spec before completing this one.
+const RetargetByNameAnnotation = "evictionrequest.coordination.uber.com/retarget-by-name"
+
+// EvictionRequestCancellationPolicy defines the cancellation policy for eviction requests.
+// +enum
+type EvictionRequestCancellationPolicy string
+
+const (
+	// Allow policy allows cancellation of this eviction request.
+	// The EvictionRequest can be deleted before the target is fully evicted (e.g. before the pod is
+	// fully terminated).
+	Allow EvictionRequestCancellationPolicy = "Allow"
+	// Forbid policy forbids cancellation of this eviction request.
+	// The EvictionRequest can't be deleted until the target is fully evicted (e.g. until the pod is
+	// fully terminated).
+	Forbid EvictionRequestCancellationPolicy = "Forbid"
+)
+
+// PodEvictionStatus is the status of the pod eviction.
+// +k8s:deepcopy-gen=true
+type PodEvictionStatus struct {
+	// The number of unsuccessful attempts to evict the referenced pod via the API-initiated eviction,
+	// e.g. due to a PodDisruptionBudget.
+	// This is set by the eviction controller after all the interceptors have completed.
+	// The minimum value is 0, and subsequent updates can only increase it.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:default=0
+	// +kubebuilder:validation:XValidation:rule="self >= oldSelf",message="failedAPIEvictionCounter can only increase"
+	FailedAPIEvictionCounter int32 `json:"failedAPIEvictionCounter"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,shortName=evreq
+// +kubebuilder:printcolumn:name="Pod",type="string",JSONPath=".spec.target.podRef.name",description="Target pod for eviction"
+// +kubebuilder:printcolumn:name="Node",type="string",JSONPath=".spec.target.nodeRef.name",description="Target node for eviction"
+// +kubebuilder:printcolumn:name="ActiveInterceptor",type="string",JSONPath=".status.activeInterceptor.interceptorClass",description="Current active interceptor"
+// +kubebuilder:printcolumn:name="Heartbeat",type="date",JSONPath=".status.activeInterceptor.heartbeatTime",description="Last heartbeat"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+// +genclient
+// +k8s:deepcopy-gen=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// EvictionRequest is the Schema for the evictionrequests API
+type EvictionRequest struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines the eviction request specification.
+	// https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#spec-and-status
+	// This field is required.
+	// +required
+	Spec EvictionRequestSpec `json:"spec"`
+	// Status represents the most recently observed status of the eviction request.
+	// Populated by the current interceptor and eviction request controller.
+	// More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#spec-and-status
+	// +optional
+	Status EvictionRequestStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +k8s:deepcopy-gen=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// EvictionRequestList contains a list of EvictionRequest
+type EvictionRequestList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []EvictionRequest `json:"items"`
+}
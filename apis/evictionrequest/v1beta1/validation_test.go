@@ -0,0 +1,121 @@
+package v1beta1_test
+
+import (
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"code.uber.internal/apis/evictionrequest/v1beta1"
+	"code.uber.internal/pkg/testutil/crdvalidation"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+// _crd is the manifest generated from the markers of the API types
+var _crd = filepath.Join("..", "..", "..", "config", "crd", "bases", "evictionrequest.coordination.uber.com_evictionrequests.yaml")
+
+func newEvictionRequest() *v1beta1.EvictionRequest {
+	return &v1beta1.EvictionRequest{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "pod"},
+		Spec: v1beta1.EvictionRequestSpec{
+			Type:                     v1beta1.Soft,
+			Target:                   v1beta1.EvictionTarget{PodRef: &v1beta1.LocalPodReference{Name: "pod", UID: "pod-uid"}},
+			Requesters:               []v1beta1.Requester{{Name: "requester.example.com"}},
+			Interceptors:             []v1beta1.Interceptor{{InterceptorClass: "interceptor.example.com", Priority: 100}},
+			HeartbeatDeadlineSeconds: ptr.To[int32](600),
+		},
+		Status: v1beta1.EvictionRequestStatus{
+			PodEvictionStatus: &v1beta1.PodEvictionStatus{FailedAPIEvictionCounter: 2},
+		},
+	}
+}
+
+func TestValidationRules(t *testing.T) {
+	validator, err := crdvalidation.New(_crd, "v1beta1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tc := range []struct {
+		name string
+		// create validates the eviction request on creation instead of as an update
+		create         bool
+		update         func(evictionRequest *v1beta1.EvictionRequest)
+		expectedErrors []string
+	}{
+		{name: "unchanged", update: func(*v1beta1.EvictionRequest) {}},
+		{
+			name:   "node target",
+			create: true,
+			update: func(evictionRequest *v1beta1.EvictionRequest) {
+				evictionRequest.Spec.Target = v1beta1.EvictionTarget{NodeRef: &v1beta1.NodeReference{Name: "node", UID: "node-uid"}}
+			},
+		},
+		{
+			name:   "pod and node targets",
+			create: true,
+			update: func(evictionRequest *v1beta1.EvictionRequest) {
+				evictionRequest.Spec.Target.NodeRef = &v1beta1.NodeReference{Name: "node", UID: "node-uid"}
+			},
+			expectedErrors: []string{".spec.target: exactly one of podRef and nodeRef is required"},
+		},
+		{
+			name:   "no target",
+			create: true,
+			update: func(evictionRequest *v1beta1.EvictionRequest) {
+				evictionRequest.Spec.Target = v1beta1.EvictionTarget{}
+			},
+			expectedErrors: []string{".spec.target: exactly one of podRef and nodeRef is required"},
+		},
+		{
+			name: "target",
+			update: func(evictionRequest *v1beta1.EvictionRequest) {
+				evictionRequest.Spec.Target = v1beta1.EvictionTarget{NodeRef: &v1beta1.NodeReference{Name: "node", UID: "node-uid"}}
+			},
+			expectedErrors: []string{".spec.target: target is immutable"},
+		},
+		{
+			name: "interceptors",
+			update: func(evictionRequest *v1beta1.EvictionRequest) {
+				evictionRequest.Spec.Interceptors = append(evictionRequest.Spec.Interceptors,
+					v1beta1.Interceptor{InterceptorClass: "other.example.com", Priority: 50})
+			},
+			expectedErrors: []string{".spec.interceptors: interceptors is immutable"},
+		},
+		{
+			name: "failed eviction counter decreased",
+			update: func(evictionRequest *v1beta1.EvictionRequest) {
+				evictionRequest.Status.PodEvictionStatus.FailedAPIEvictionCounter = 1
+			},
+			expectedErrors: []string{
+				".status.podEvictionStatus.failedAPIEvictionCounter: failedAPIEvictionCounter can only increase",
+			},
+		},
+		{
+			name: "pod eviction status removed",
+			update: func(evictionRequest *v1beta1.EvictionRequest) {
+				evictionRequest.Status.PodEvictionStatus = nil
+			},
+			expectedErrors: []string{".status: podEvictionStatus cannot be removed"},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			oldEvictionRequest := newEvictionRequest()
+			evictionRequest := oldEvictionRequest.DeepCopy()
+			tc.update(evictionRequest)
+
+			var errs []string
+			if tc.create {
+				errs, err = validator.Validate(nil, evictionRequest)
+			} else {
+				errs, err = validator.Validate(oldEvictionRequest, evictionRequest)
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(errs, tc.expectedErrors) {
+				t.Errorf("Validate() = %q, expected %q", errs, tc.expectedErrors)
+			}
+		})
+	}
+}
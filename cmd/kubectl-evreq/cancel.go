@@ -0,0 +1,86 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"code.uber.internal/apis/evictionrequest/v1alpha1"
+	"github.com/spf13/pflag"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
+)
+
+const _cancelUsage = "kubectl evreq cancel NAME (--requester NAME | --all)"
+
+// cancelOptions holds the flags of the cancel command
+type cancelOptions struct {
+	requester string
+	all       bool
+}
+
+func newCancelCommand() command {
+	o := &cancelOptions{}
+	return command{
+		flags: func(fs *pflag.FlagSet) {
+			fs.StringVar(&o.requester, "requester", "", "Requester to remove from the EvictionRequest")
+			fs.BoolVar(&o.all, "all", false, "Remove all requesters, which cancels the EvictionRequest")
+		},
+		run: o.run,
+	}
+}
+
+// run removes the requester from the EvictionRequest. Once no requesters are left the request is canceled.
+func (o *cancelOptions) run(ctx context.Context, c *clients, args []string) error {
+	if err := exactArgs(args, 1, _cancelUsage); err != nil {
+		return err
+	}
+	if (o.requester == "") == !o.all {
+		return fmt.Errorf("exactly one of --requester or --all is required\nusage: %s", _cancelUsage)
+	}
+
+	client := c.evictionRequestClient.EvictionrequestV1alpha1().EvictionRequests(c.namespace)
+
+	var remaining int
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		evictionRequest, err := client.Get(ctx, args[0], metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+
+		if evictionRequest.Status.EvictionRequestCancellationPolicy == v1alpha1.Forbid {
+			return fmt.Errorf("eviction request %s has cancellation policy %s", evictionRequest.Name, v1alpha1.Forbid)
+		}
+		if meta.IsStatusConditionTrue(evictionRequest.Status.Conditions, string(v1alpha1.EvictionRequestComplete)) {
+			return fmt.Errorf("eviction request %s is already complete", evictionRequest.Name)
+		}
+
+		requesters := make([]v1alpha1.Requester, 0, len(evictionRequest.Spec.Requesters))
+		for _, requester := range evictionRequest.Spec.Requesters {
+			if !o.all && requester.Name != o.requester {
+				requesters = append(requesters, requester)
+			}
+		}
+		if o.all && len(evictionRequest.Spec.Requesters) == 0 {
+			return fmt.Errorf("eviction request %s is already canceled", evictionRequest.Name)
+		}
+		if len(requesters) == len(evictionRequest.Spec.Requesters) {
+			return fmt.Errorf("requester %q not found in eviction request %s", o.requester, evictionRequest.Name)
+		}
+
+		evictionRequest.Spec.Requesters = requesters
+		remaining = len(requesters)
+		_, err = client.Update(ctx, evictionRequest, metav1.UpdateOptions{})
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("failed to cancel eviction request: %w", err)
+	}
+
+	if remaining == 0 {
+		fmt.Printf("evictionrequest/%s canceled\n", args[0])
+	} else {
+		fmt.Printf("evictionrequest/%s requester removed, %d remaining\n", args[0], remaining)
+	}
+	return nil
+}
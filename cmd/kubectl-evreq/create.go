@@ -0,0 +1,186 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"code.uber.internal/apis/evictionrequest/v1alpha1"
+	"code.uber.internal/pkg/duplicate"
+	"github.com/spf13/pflag"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
+)
+
+const _createUsage = "kubectl evreq create POD --requester NAME [--interceptor CLASS[:PRIORITY[:ROLE]]]... [--name NAME] [--heartbeat-deadline SECONDS] [--retarget-by-name]"
+
+// createOptions holds the flags of the create command
+type createOptions struct {
+	name                     string
+	requesters               []string
+	interceptors             []string
+	heartbeatDeadlineSeconds int32
+	retargetByName           bool
+}
+
+func newCreateCommand() command {
+	o := &createOptions{}
+	return command{
+		flags: func(fs *pflag.FlagSet) {
+			fs.StringVar(&o.name, "name", "", "Name of the EvictionRequest (defaults to the pod name)")
+			fs.StringArrayVar(&o.requesters, "requester", nil, "Requester of the eviction, may be repeated")
+			fs.StringArrayVar(&o.interceptors, "interceptor", nil, "Interceptor as CLASS[:PRIORITY[:ROLE]], may be repeated. Without a priority, the default priority and role of the InterceptorClass are used")
+			fs.Int32Var(&o.heartbeatDeadlineSeconds, "heartbeat-deadline", 1800, "Heartbeat deadline of the interceptors in seconds")
+			fs.BoolVar(&o.retargetByName, "retarget-by-name", false, "Retarget the EvictionRequest to the pod replacing the target with the same name")
+		},
+		run: o.run,
+	}
+}
+
+// run looks up the target pod and creates an EvictionRequest referencing it by name and UID
+func (o *createOptions) run(ctx context.Context, c *clients, args []string) error {
+	if err := exactArgs(args, 1, _createUsage); err != nil {
+		return err
+	}
+	if len(o.requesters) == 0 {
+		return fmt.Errorf("at least one --requester is required\nusage: %s", _createUsage)
+	}
+
+	interceptors, err := parseInterceptors(o.interceptors, func(name string) (*v1alpha1.InterceptorClass, error) {
+		return c.evictionRequestClient.EvictionrequestV1alpha1().InterceptorClasses().Get(ctx, name, metav1.GetOptions{})
+	})
+	if err != nil {
+		return err
+	}
+
+	pod, err := c.kubeClient.CoreV1().Pods(c.namespace).Get(ctx, args[0], metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to get pod: %w", err)
+	}
+
+	name := o.name
+	if name == "" {
+		name = pod.Name
+	}
+
+	requesters := make([]v1alpha1.Requester, 0, len(o.requesters))
+	for _, requester := range o.requesters {
+		requesters = append(requesters, v1alpha1.Requester{Name: requester})
+	}
+
+	// Join the eviction request already targeting the pod instead of creating a duplicate
+	client := c.evictionRequestClient.EvictionrequestV1alpha1().EvictionRequests(pod.Namespace)
+	list, err := client.List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to list eviction requests: %w", err)
+	}
+	evictionRequests := make([]*v1alpha1.EvictionRequest, 0, len(list.Items))
+	for i := range list.Items {
+		evictionRequests = append(evictionRequests, &list.Items[i])
+	}
+	if existing := duplicate.Primary(duplicate.Active(evictionRequests, string(pod.UID))); existing != nil {
+		return joinEvictionRequest(ctx, c, existing, requesters)
+	}
+
+	heartbeatDeadlineSeconds := o.heartbeatDeadlineSeconds
+	var annotations map[string]string
+	if o.retargetByName {
+		annotations = map[string]string{v1alpha1.RetargetByNameAnnotation: "true"}
+	}
+	evictionRequest := &v1alpha1.EvictionRequest{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        name,
+			Namespace:   pod.Namespace,
+			Annotations: annotations,
+		},
+		Spec: v1alpha1.EvictionRequestSpec{
+			Type: v1alpha1.Soft,
+			Target: v1alpha1.EvictionTarget{
+				PodRef: &v1alpha1.LocalPodReference{
+					Name: pod.Name,
+					UID:  string(pod.UID),
+				},
+			},
+			Requesters:               requesters,
+			Interceptors:             interceptors,
+			HeartbeatDeadlineSeconds: &heartbeatDeadlineSeconds,
+		},
+	}
+
+	created, err := client.Create(ctx, evictionRequest, metav1.CreateOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to create eviction request: %w", err)
+	}
+
+	fmt.Printf("evictionrequest/%s created for pod %s (uid %s)\n", created.Name, pod.Name, pod.UID)
+	return nil
+}
+
+// joinEvictionRequest adds the requesters to an existing eviction request targeting the same pod
+func joinEvictionRequest(ctx context.Context, c *clients, evictionRequest *v1alpha1.EvictionRequest, requesters []v1alpha1.Requester) error {
+	client := c.evictionRequestClient.EvictionrequestV1alpha1().EvictionRequests(evictionRequest.Namespace)
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		current, err := client.Get(ctx, evictionRequest.Name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		if !duplicate.MergeRequesters(current, requesters) {
+			return nil
+		}
+		_, err = client.Update(ctx, current, metav1.UpdateOptions{})
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("failed to add requesters to eviction request %s: %w", evictionRequest.Name, err)
+	}
+
+	fmt.Printf("evictionrequest/%s already targets pod %s, requesters joined\n", evictionRequest.Name, evictionRequest.Spec.Target.PodRef.Name)
+	return nil
+}
+
+// parseInterceptors parses interceptors given as CLASS[:PRIORITY[:ROLE]]. A missing priority or role
+// is taken from the InterceptorClass returned by getClass.
+func parseInterceptors(values []string, getClass func(name string) (*v1alpha1.InterceptorClass, error)) ([]v1alpha1.Interceptor, error) {
+	interceptors := make([]v1alpha1.Interceptor, 0, len(values))
+	seen := make(map[string]bool, len(values))
+	for _, value := range values {
+		parts := strings.Split(value, ":")
+		if len(parts) > 3 || parts[0] == "" {
+			return nil, fmt.Errorf("invalid interceptor %q, expected CLASS[:PRIORITY[:ROLE]]", value)
+		}
+
+		if seen[parts[0]] {
+			return nil, fmt.Errorf("interceptor class %q is given more than once", parts[0])
+		}
+		seen[parts[0]] = true
+
+		interceptor := v1alpha1.Interceptor{InterceptorClass: parts[0]}
+		if len(parts) == 3 && parts[2] != "" {
+			role := parts[2]
+			interceptor.Role = &role
+		}
+
+		if len(parts) >= 2 && parts[1] != "" {
+			priority, err := strconv.ParseInt(parts[1], 10, 32)
+			if err != nil {
+				return nil, fmt.Errorf("invalid priority in interceptor %q: %w", value, err)
+			}
+			interceptor.Priority = int32(priority)
+		} else {
+			class, err := getClass(parts[0])
+			if err != nil {
+				return nil, fmt.Errorf("no priority given for interceptor %q and its InterceptorClass can't be read: %w", value, err)
+			}
+			if class.Spec.DefaultPriority == nil {
+				return nil, fmt.Errorf("no priority given for interceptor %q and InterceptorClass %s has no default priority", value, class.Name)
+			}
+			interceptor.Priority = *class.Spec.DefaultPriority
+			if interceptor.Role == nil {
+				interceptor.Role = class.Spec.DefaultRole
+			}
+		}
+		interceptors = append(interceptors, interceptor)
+	}
+	return interceptors, nil
+}
@@ -0,0 +1,270 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"code.uber.internal/apis/evictionrequest/v1alpha1"
+	"code.uber.internal/pkg/reconciler/interceptor"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/duration"
+)
+
+const _describeUsage = "kubectl evreq describe NAME"
+
+const _none = "<none>"
+
+func newGetCommand() command {
+	return command{run: runGet}
+}
+
+func newDescribeCommand() command {
+	return command{run: runDescribe}
+}
+
+// runGet prints a table of all EvictionRequests in the namespace, or of the named one
+func runGet(ctx context.Context, c *clients, args []string) error {
+	client := c.evictionRequestClient.EvictionrequestV1alpha1().EvictionRequests(c.namespace)
+
+	var items []v1alpha1.EvictionRequest
+	switch len(args) {
+	case 0:
+		list, err := client.List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return fmt.Errorf("failed to list eviction requests: %w", err)
+		}
+		items = list.Items
+	case 1:
+		evictionRequest, err := client.Get(ctx, args[0], metav1.GetOptions{})
+		if err != nil {
+			return fmt.Errorf("failed to get eviction request: %w", err)
+		}
+		items = append(items, *evictionRequest)
+	default:
+		return fmt.Errorf("expected at most 1 argument, got %d", len(args))
+	}
+
+	if len(items) == 0 {
+		fmt.Fprintf(os.Stderr, "No eviction requests found in %s namespace.\n", c.namespace)
+		return nil
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 8, 3, ' ', 0)
+	fmt.Fprintln(w, "NAME\tPOD\tREQUESTERS\tACTIVE INTERCEPTOR\tCOMPLETED\tHEARTBEAT\tAGE")
+	now := time.Now()
+	for i := range items {
+		evictionRequest := &items[i]
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%t\t%s\t%s\n",
+			evictionRequest.Name,
+			podName(evictionRequest),
+			requesterNames(evictionRequest),
+			orNone(activeInterceptorClass(evictionRequest)),
+			evictionRequest.Status.ActiveInterceptorCompleted,
+			since(now, evictionRequest.Status.HeartbeatTime),
+			since(now, &evictionRequest.CreationTimestamp),
+		)
+	}
+	return w.Flush()
+}
+
+// runDescribe prints the details of an EvictionRequest together with its interceptor timeline
+func runDescribe(ctx context.Context, c *clients, args []string) error {
+	if err := exactArgs(args, 1, _describeUsage); err != nil {
+		return err
+	}
+
+	evictionRequest, err := c.evictionRequestClient.EvictionrequestV1alpha1().EvictionRequests(c.namespace).Get(ctx, args[0], metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to get eviction request: %w", err)
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 8, 2, ' ', 0)
+	describe(w, evictionRequest, time.Now())
+	return w.Flush()
+}
+
+// describe writes the human readable description of an EvictionRequest
+func describe(w io.Writer, evictionRequest *v1alpha1.EvictionRequest, now time.Time) {
+	status := evictionRequest.Status
+
+	fmt.Fprintf(w, "Name:\t%s\n", evictionRequest.Name)
+	fmt.Fprintf(w, "Namespace:\t%s\n", evictionRequest.Namespace)
+	fmt.Fprintf(w, "Created:\t%s ago\n", since(now, &evictionRequest.CreationTimestamp))
+	fmt.Fprintf(w, "Type:\t%s\n", evictionRequest.Spec.Type)
+	if podRef := evictionRequest.Spec.Target.PodRef; podRef != nil {
+		fmt.Fprintf(w, "Target Pod:\t%s (uid %s)\n", podRef.Name, podRef.UID)
+	}
+	fmt.Fprintf(w, "Requesters:\t%s\n", requesterNames(evictionRequest))
+	if evictionRequest.Spec.HeartbeatDeadlineSeconds != nil {
+		fmt.Fprintf(w, "Heartbeat Deadline:\t%s\n", time.Duration(*evictionRequest.Spec.HeartbeatDeadlineSeconds)*time.Second)
+	}
+	fmt.Fprintf(w, "Cancellation Policy:\t%s\n", status.EvictionRequestCancellationPolicy)
+	if status.PodEvictionStatus != nil {
+		fmt.Fprintf(w, "Failed Evictions:\t%d\n", status.PodEvictionStatus.FailedAPIEvictionCounter)
+	}
+	if status.Message != "" {
+		fmt.Fprintf(w, "Message:\t%s\n", status.Message)
+	}
+
+	fmt.Fprintln(w, "Interceptor Timeline:")
+	if len(evictionRequest.Spec.Interceptors) == 0 {
+		fmt.Fprintln(w, "  <none>")
+	} else {
+		fmt.Fprintln(w, "  PRIORITY\tCLASS\tROLE\tSTATE")
+		for _, entry := range timeline(evictionRequest, now) {
+			fmt.Fprintf(w, "  %d\t%s\t%s\t%s\n", entry.interceptor.Priority, entry.interceptor.InterceptorClass, roleOf(entry.interceptor), entry.state)
+		}
+	}
+
+	fmt.Fprintln(w, "Interceptor History:")
+	if len(status.InterceptorHistory) == 0 {
+		fmt.Fprintln(w, "  <none>")
+	} else {
+		fmt.Fprintln(w, "  CLASS\tSELECTED\tADOPTED AFTER\tACTIVE FOR\tRESULT\tFINISH TIME ERROR")
+		for _, entry := range status.InterceptorHistory {
+			describeHistoryEntry(w, entry, now)
+		}
+	}
+
+	fmt.Fprintln(w, "Conditions:")
+	if len(status.Conditions) == 0 {
+		fmt.Fprintln(w, "  <none>")
+		return
+	}
+	fmt.Fprintln(w, "  TYPE\tSTATUS\tREASON\tLAST TRANSITION\tMESSAGE")
+	for _, condition := range status.Conditions {
+		fmt.Fprintf(w, "  %s\t%s\t%s\t%s ago\t%s\n", condition.Type, condition.Status, condition.Reason, since(now, &condition.LastTransitionTime), condition.Message)
+	}
+}
+
+// timelineEntry is a single interceptor in the order of selection with its state
+type timelineEntry struct {
+	interceptor v1alpha1.Interceptor
+	state       string
+}
+
+// timeline orders the interceptors the way the controller selects them and derives the state of each
+// from the active interceptor in the status
+func timeline(evictionRequest *v1alpha1.EvictionRequest, now time.Time) []timelineEntry {
+	status := evictionRequest.Status
+	active := activeInterceptorClass(evictionRequest)
+
+	activeIndex := -1
+	interceptors := interceptor.SortByPriority(evictionRequest.Spec.Interceptors)
+	for idx, candidate := range interceptors {
+		if candidate.InterceptorClass == active {
+			activeIndex = idx
+		}
+	}
+
+	entries := make([]timelineEntry, 0, len(interceptors))
+	for idx, candidate := range interceptors {
+		var state string
+		switch {
+		case activeIndex < 0 || idx > activeIndex:
+			state = "Pending"
+		case idx < activeIndex:
+			state = "Passed"
+		case status.ActiveInterceptorCompleted:
+			state = "Completed"
+		default:
+			state = activeState(status, now)
+		}
+		entries = append(entries, timelineEntry{interceptor: candidate, state: state})
+	}
+	return entries
+}
+
+// activeState describes the progress reported by the active interceptor
+func activeState(status v1alpha1.EvictionRequestStatus, now time.Time) string {
+	details := []string{}
+	if status.HeartbeatTime == nil {
+		details = append(details, "not adopted yet")
+	} else {
+		details = append(details, fmt.Sprintf("heartbeat %s ago", since(now, status.HeartbeatTime)))
+	}
+	if status.ExpectedInterceptorFinishTime != nil {
+		if remaining := status.ExpectedInterceptorFinishTime.Sub(now); remaining > 0 {
+			details = append(details, fmt.Sprintf("expected to finish in %s", duration.HumanDuration(remaining)))
+		} else {
+			details = append(details, fmt.Sprintf("expected to finish %s ago", duration.HumanDuration(-remaining)))
+		}
+	}
+	return fmt.Sprintf("Active (%s)", strings.Join(details, ", "))
+}
+
+// describeHistoryEntry writes where the eviction time was spent for one interceptor
+func describeHistoryEntry(w io.Writer, entry v1alpha1.InterceptorHistoryEntry, now time.Time) {
+	end, result := now, "Active"
+	if entry.CompletionTime != nil {
+		end, result = entry.CompletionTime.Time, orNone(string(entry.Reason))
+	}
+
+	adoptedAfter := _none
+	if entry.FirstHeartbeatTime != nil {
+		adoptedAfter = duration.HumanDuration(entry.FirstHeartbeatTime.Sub(entry.SelectionTime.Time))
+	}
+
+	finishTimeError := "-"
+	if entry.FinishTimeErrorSeconds != nil {
+		finishTimeError = (time.Duration(*entry.FinishTimeErrorSeconds) * time.Second).String()
+		if *entry.FinishTimeErrorSeconds > 0 {
+			finishTimeError = "+" + finishTimeError
+		}
+	}
+
+	fmt.Fprintf(w, "  %s\t%s ago\t%s\t%s\t%s\t%s\n", entry.InterceptorClass, since(now, &entry.SelectionTime), adoptedAfter,
+		duration.HumanDuration(end.Sub(entry.SelectionTime.Time)), result, finishTimeError)
+}
+
+func podName(evictionRequest *v1alpha1.EvictionRequest) string {
+	if evictionRequest.Spec.Target.PodRef == nil {
+		return _none
+	}
+	return evictionRequest.Spec.Target.PodRef.Name
+}
+
+func requesterNames(evictionRequest *v1alpha1.EvictionRequest) string {
+	if len(evictionRequest.Spec.Requesters) == 0 {
+		return _none
+	}
+	names := make([]string, 0, len(evictionRequest.Spec.Requesters))
+	for _, requester := range evictionRequest.Spec.Requesters {
+		names = append(names, requester.Name)
+	}
+	return strings.Join(names, ",")
+}
+
+func activeInterceptorClass(evictionRequest *v1alpha1.EvictionRequest) string {
+	if evictionRequest.Status.ActiveInterceptorClass == nil {
+		return ""
+	}
+	return *evictionRequest.Status.ActiveInterceptorClass
+}
+
+func orNone(value string) string {
+	if value == "" {
+		return _none
+	}
+	return value
+}
+
+func roleOf(interceptor v1alpha1.Interceptor) string {
+	if interceptor.Role == nil || *interceptor.Role == "" {
+		return "-"
+	}
+	return *interceptor.Role
+}
+
+// since formats the time elapsed since t, or <none> if t is not set
+func since(now time.Time, t *metav1.Time) string {
+	if t == nil || t.IsZero() {
+		return _none
+	}
+	return duration.HumanDuration(now.Sub(t.Time))
+}
@@ -0,0 +1,141 @@
+// Command kubectl-evreq is a kubectl plugin for creating, inspecting and canceling EvictionRequests.
+//
+// Install it by placing the binary on the PATH, then run it as `kubectl evreq <command>`.
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+
+	"code.uber.internal/pkg/generated/clientset/versioned"
+	"github.com/spf13/pflag"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+const _usage = `Usage: kubectl evreq <command> [flags]
+
+Commands:
+  create POD      Create an EvictionRequest for a pod
+  get [NAME]      List EvictionRequests, or show a single one
+  describe NAME   Show an EvictionRequest with its interceptor timeline
+  cancel NAME     Remove a requester from an EvictionRequest
+  watch [NAME]    Stream changes to EvictionRequests
+
+Run 'kubectl evreq <command> --help' for the flags of a command.
+`
+
+// command is a single kubectl-evreq subcommand
+type command struct {
+	// flags registers the command specific flags
+	flags func(fs *pflag.FlagSet)
+	// run executes the command with the positional arguments left after flag parsing
+	run func(ctx context.Context, c *clients, args []string) error
+}
+
+func main() {
+	if len(os.Args) < 2 {
+		fmt.Fprint(os.Stderr, _usage)
+		os.Exit(2)
+	}
+
+	commands := map[string]command{
+		"create":   newCreateCommand(),
+		"get":      newGetCommand(),
+		"describe": newDescribeCommand(),
+		"cancel":   newCancelCommand(),
+		"watch":    newWatchCommand(),
+	}
+
+	name := os.Args[1]
+	cmd, ok := commands[name]
+	if !ok {
+		if name != "-h" && name != "--help" && name != "help" {
+			fmt.Fprintf(os.Stderr, "unknown command %q\n\n", name)
+		}
+		fmt.Fprint(os.Stderr, _usage)
+		os.Exit(2)
+	}
+
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer cancel()
+
+	if err := execute(ctx, name, cmd, os.Args[2:]); err != nil {
+		fmt.Fprintf(os.Stderr, "error: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+// execute parses the flags of a command, builds the clients and runs it
+func execute(ctx context.Context, name string, cmd command, args []string) error {
+	fs := pflag.NewFlagSet("kubectl evreq "+name, pflag.ContinueOnError)
+	loadingRules := clientcmd.NewDefaultClientConfigLoadingRules()
+	overrides := &clientcmd.ConfigOverrides{}
+	fs.StringVar(&loadingRules.ExplicitPath, "kubeconfig", "", "Path to the kubeconfig file to use")
+	fs.StringVar(&overrides.CurrentContext, "context", "", "The name of the kubeconfig context to use")
+	fs.StringVarP(&overrides.Context.Namespace, "namespace", "n", "", "The namespace scope for this request")
+	if cmd.flags != nil {
+		cmd.flags(fs)
+	}
+
+	if err := fs.Parse(args); err != nil {
+		if err == pflag.ErrHelp {
+			return nil
+		}
+		return err
+	}
+
+	clientConfig := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(loadingRules, overrides)
+	c, err := newClients(clientConfig)
+	if err != nil {
+		return err
+	}
+
+	return cmd.run(ctx, c, fs.Args())
+}
+
+// clients holds the API clients and the namespace a command operates on
+type clients struct {
+	kubeClient            kubernetes.Interface
+	evictionRequestClient versioned.Interface
+	namespace             string
+}
+
+// newClients creates the Kubernetes and EvictionRequest clients from the kubeconfig
+func newClients(clientConfig clientcmd.ClientConfig) (*clients, error) {
+	namespace, _, err := clientConfig.Namespace()
+	if err != nil {
+		return nil, err
+	}
+
+	restConfig, err := clientConfig.ClientConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	kubeClient, err := kubernetes.NewForConfig(restConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	evictionRequestClient, err := versioned.NewForConfig(restConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	return &clients{
+		kubeClient:            kubeClient,
+		evictionRequestClient: evictionRequestClient,
+		namespace:             namespace,
+	}, nil
+}
+
+// exactArgs returns an error unless exactly n positional arguments were given
+func exactArgs(args []string, n int, usage string) error {
+	if len(args) != n {
+		return fmt.Errorf("expected %d argument(s), got %d\nusage: %s", n, len(args), usage)
+	}
+	return nil
+}
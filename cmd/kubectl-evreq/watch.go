@@ -0,0 +1,105 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"code.uber.internal/apis/evictionrequest/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/tools/cache"
+	watchtools "k8s.io/client-go/tools/watch"
+)
+
+func newWatchCommand() command {
+	return command{run: runWatch}
+}
+
+// runWatch prints the current EvictionRequests and then one line for every change until interrupted
+func runWatch(ctx context.Context, c *clients, args []string) error {
+	if len(args) > 1 {
+		return fmt.Errorf("expected at most 1 argument, got %d", len(args))
+	}
+
+	fieldSelector := fields.Everything()
+	if len(args) == 1 {
+		fieldSelector = fields.OneTermEqualSelector("metadata.name", args[0])
+	}
+
+	client := c.evictionRequestClient.EvictionrequestV1alpha1().EvictionRequests(c.namespace)
+	list, err := client.List(ctx, metav1.ListOptions{FieldSelector: fieldSelector.String()})
+	if err != nil {
+		return fmt.Errorf("failed to list eviction requests: %w", err)
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 8, 3, ' ', 0)
+	fmt.Fprintln(w, "TIME\tEVENT\tNAME\tREQUESTERS\tACTIVE INTERCEPTOR\tCOMPLETED\tHEARTBEAT\tCONDITIONS")
+	for i := range list.Items {
+		printWatchEvent(w, watch.Added, &list.Items[i])
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	watcher, err := watchtools.NewRetryWatcherWithContext(ctx, list.ResourceVersion, &cache.ListWatch{
+		WatchFuncWithContext: func(ctx context.Context, options metav1.ListOptions) (watch.Interface, error) {
+			options.FieldSelector = fieldSelector.String()
+			return client.Watch(ctx, options)
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to watch eviction requests: %w", err)
+	}
+	defer watcher.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-watcher.Done():
+			return nil
+		case event, ok := <-watcher.ResultChan():
+			if !ok {
+				return nil
+			}
+			evictionRequest, ok := event.Object.(*v1alpha1.EvictionRequest)
+			if !ok {
+				continue
+			}
+			printWatchEvent(w, event.Type, evictionRequest)
+			if err := w.Flush(); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// printWatchEvent writes a single line describing the state of an EvictionRequest after an event
+func printWatchEvent(w *tabwriter.Writer, eventType watch.EventType, evictionRequest *v1alpha1.EvictionRequest) {
+	now := time.Now()
+	conditions := ""
+	for _, condition := range evictionRequest.Status.Conditions {
+		if conditions != "" {
+			conditions += ","
+		}
+		conditions += fmt.Sprintf("%s=%s", condition.Type, condition.Status)
+	}
+	if conditions == "" {
+		conditions = _none
+	}
+
+	fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%t\t%s\t%s\n",
+		now.Format(time.TimeOnly),
+		eventType,
+		evictionRequest.Name,
+		requesterNames(evictionRequest),
+		orNone(activeInterceptorClass(evictionRequest)),
+		evictionRequest.Status.ActiveInterceptorCompleted,
+		since(now, evictionRequest.Status.HeartbeatTime),
+		conditions,
+	)
+}
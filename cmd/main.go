@@ -4,16 +4,24 @@ import (
 	"code.uber.internal/pkg/config"
 	"code.uber.internal/pkg/constants"
 	"code.uber.internal/pkg/controller"
+	"code.uber.internal/pkg/events"
+	"code.uber.internal/pkg/gc"
 	"code.uber.internal/pkg/generated/clientset/versioned"
 	evireqinformers "code.uber.internal/pkg/generated/informers/externalversions"
 	evreqlisters "code.uber.internal/pkg/generated/listers/evictionrequest/v1alpha1"
+	"code.uber.internal/pkg/informer"
+	"code.uber.internal/pkg/metrics"
+	"code.uber.internal/pkg/migration"
 	"code.uber.internal/pkg/reconciler"
+	"code.uber.internal/pkg/shadow"
+	"code.uber.internal/pkg/webhook"
 	"code.uber.internal/pkg/worker"
 	"go.uber.org/fx"
 	"go.uber.org/zap"
 	"k8s.io/client-go/informers"
 	"k8s.io/client-go/kubernetes"
 	corev1listers "k8s.io/client-go/listers/core/v1"
+	"k8s.io/utils/clock"
 )
 
 func main() {
@@ -21,23 +29,44 @@ func main() {
 		reconciler.Module,
 		fx.Provide(
 			config.NewClients,
+			config.NewOptions,
+			events.New,
+			newClock,
 			// Kubernetes informer factory and listers.
 			newKubeInformerFactory,
 			newPodLister,
+			newNamespaceLister,
 			// EvictionRequest informer factory and listers.
 			newEvictionRequestInformerFactory,
 			newEvictionRequestLister,
+			newInterceptorClassLister,
+			newEvictionScheduleLister,
+			newEvictionPolicyLister,
 
 			controller.New,
 			worker.New,
+			gc.New,
+			metrics.New,
+			migration.New,
+			shadow.New,
+			webhook.New,
 			zap.NewDevelopment,
 		),
+		// In shadow mode, the clients send their writes as dry runs
+		fx.Decorate(shadow.DecorateClients),
 		fx.Invoke(run),
 	).Run()
 }
 
-func run(controller controller.Interface) {
+func run(controller controller.Interface, webhook webhook.Interface, metrics metrics.Interface) {
 	controller.Start()
+	webhook.Start()
+	metrics.Start()
+}
+
+// newClock provides the wall clock, replaced by a fake clock in tests and simulations
+func newClock() clock.Clock {
+	return clock.RealClock{}
 }
 
 func newEvictionRequestInformerFactory(evictionRequestClient versioned.Interface) evireqinformers.SharedInformerFactory {
@@ -48,10 +77,28 @@ func newEvictionRequestLister(evictionRequestInformerFactory evireqinformers.Sha
 	return evictionRequestInformerFactory.Evictionrequest().V1alpha1().EvictionRequests().Lister()
 }
 
+func newInterceptorClassLister(evictionRequestInformerFactory evireqinformers.SharedInformerFactory) evreqlisters.InterceptorClassLister {
+	return evictionRequestInformerFactory.Evictionrequest().V1alpha1().InterceptorClasses().Lister()
+}
+
+func newEvictionScheduleLister(evictionRequestInformerFactory evireqinformers.SharedInformerFactory) evreqlisters.EvictionScheduleLister {
+	return evictionRequestInformerFactory.Evictionrequest().V1alpha1().EvictionSchedules().Lister()
+}
+
+func newEvictionPolicyLister(evictionRequestInformerFactory evireqinformers.SharedInformerFactory) evreqlisters.EvictionPolicyLister {
+	return evictionRequestInformerFactory.Evictionrequest().V1alpha1().EvictionPolicies().Lister()
+}
+
 func newKubeInformerFactory(kubeClient kubernetes.Interface) informers.SharedInformerFactory {
-	return informers.NewSharedInformerFactory(kubeClient, constants.DefaultResyncInterval)
+	return informers.NewSharedInformerFactoryWithOptions(kubeClient, constants.DefaultResyncInterval,
+		informers.WithTransform(informer.Trim),
+	)
 }
 
 func newPodLister(kubeInformerFactory informers.SharedInformerFactory) corev1listers.PodLister {
 	return kubeInformerFactory.Core().V1().Pods().Lister()
 }
+
+func newNamespaceLister(kubeInformerFactory informers.SharedInformerFactory) corev1listers.NamespaceLister {
+	return kubeInformerFactory.Core().V1().Namespaces().Lister()
+}
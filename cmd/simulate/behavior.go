@@ -0,0 +1,97 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"code.uber.internal/apis/evictionrequest/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+)
+
+// _defaultHeartbeatIntervalSeconds is the heartbeat interval of behaviors that do not set one
+const _defaultHeartbeatIntervalSeconds = 60
+
+// behaviors is the file format of the scripted interceptor behaviors
+type behaviors struct {
+	Interceptors []behavior `json:"interceptors"`
+}
+
+// behavior scripts how the implementation of an interceptor class handles the eviction requests it is
+// selected for
+type behavior struct {
+	// InterceptorClass is the interceptor class implemented
+	InterceptorClass string `json:"interceptorClass"`
+	// DurationSeconds is the time from the selection of the interceptor until it completes
+	DurationSeconds int32 `json:"durationSeconds"`
+	// HeartbeatIntervalSeconds is the interval of the heartbeats sent until completion. Defaults to 60.
+	HeartbeatIntervalSeconds int32 `json:"heartbeatIntervalSeconds,omitempty"`
+	// Stall makes the interceptor adopt eviction requests and never heartbeat again, so its deadline expires
+	Stall bool `json:"stall,omitempty"`
+}
+
+// loadBehaviors reads the behaviors by interceptor class. An empty path yields no behaviors.
+func loadBehaviors(path string) (map[string]behavior, error) {
+	byClass := map[string]behavior{}
+	if path == "" {
+		return byClass, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read behaviors: %w", err)
+	}
+	var b behaviors
+	if err := yaml.UnmarshalStrict(data, &b); err != nil {
+		return nil, fmt.Errorf("failed to parse behaviors: %w", err)
+	}
+	for _, interceptor := range b.Interceptors {
+		if interceptor.InterceptorClass == "" {
+			return nil, fmt.Errorf("behavior without interceptorClass")
+		}
+		if interceptor.DurationSeconds < 0 || interceptor.HeartbeatIntervalSeconds < 0 {
+			return nil, fmt.Errorf("behavior of %s: durations must not be negative", interceptor.InterceptorClass)
+		}
+		if interceptor.HeartbeatIntervalSeconds == 0 {
+			interceptor.HeartbeatIntervalSeconds = _defaultHeartbeatIntervalSeconds
+		}
+		byClass[interceptor.InterceptorClass] = interceptor
+	}
+	return byClass, nil
+}
+
+// next returns when the interceptor acts next on the eviction request, and false if it never does
+func (b behavior) next(status *v1alpha1.EvictionRequestStatus, entry *v1alpha1.InterceptorHistoryEntry) (time.Time, bool) {
+	if status.HeartbeatTime == nil {
+		// Adopt the eviction request right after the selection
+		return entry.SelectionTime.Time, true
+	}
+	if b.Stall {
+		return time.Time{}, false
+	}
+	completion := b.completion(entry)
+	heartbeat := status.HeartbeatTime.Add(time.Duration(b.HeartbeatIntervalSeconds) * time.Second)
+	if completion.Before(heartbeat) {
+		return completion, true
+	}
+	return heartbeat, true
+}
+
+// act heartbeats, and completes the interceptor once its duration elapsed
+func (b behavior) act(status *v1alpha1.EvictionRequestStatus, entry *v1alpha1.InterceptorHistoryEntry, now time.Time) {
+	heartbeat := metav1.NewTime(now)
+	status.HeartbeatTime = &heartbeat
+	if status.ExpectedInterceptorFinishTime == nil && !b.Stall {
+		expected := metav1.NewTime(b.completion(entry))
+		status.ExpectedInterceptorFinishTime = &expected
+	}
+	if !b.Stall && !now.Before(b.completion(entry)) {
+		status.ActiveInterceptorCompleted = true
+	}
+}
+
+// completion returns when the interceptor completes
+func (b behavior) completion(entry *v1alpha1.InterceptorHistoryEntry) time.Time {
+	return entry.SelectionTime.Add(time.Duration(b.DurationSeconds) * time.Second)
+}
@@ -0,0 +1,454 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"code.uber.internal/apis/evictionrequest/v1alpha1"
+	"code.uber.internal/pkg/duplicate"
+	"code.uber.internal/pkg/evictionpolicy"
+	evreqfake "code.uber.internal/pkg/generated/clientset/versioned/fake"
+	evreqlisters "code.uber.internal/pkg/generated/listers/evictionrequest/v1alpha1"
+	"code.uber.internal/pkg/informer"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/informers"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+	corev1listers "k8s.io/client-go/listers/core/v1"
+	k8stesting "k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/cache"
+	clocktesting "k8s.io/utils/clock/testing"
+)
+
+// _defaultHeartbeatDeadlineSeconds is the CRD default of .spec.heartbeatDeadlineSeconds, applied to snapshot
+// eviction requests that do not set it
+const _defaultHeartbeatDeadlineSeconds = 1800
+
+var (
+	_podsResource = corev1.SchemeGroupVersion.WithResource("pods")
+	_podKind      = corev1.SchemeGroupVersion.WithKind("Pod")
+	_pdbsResource = policyv1.SchemeGroupVersion.WithResource("poddisruptionbudgets")
+	_pdbKind      = policyv1.SchemeGroupVersion.WithKind("PodDisruptionBudget")
+)
+
+// cluster is the simulated cluster: fake clientsets seeded with the snapshot, listers the reconciler reads
+// synchronously, and an Eviction API honoring PodDisruptionBudgets
+type cluster struct {
+	kubeClient            *kubefake.Clientset
+	evictionRequestClient *evreqfake.Clientset
+	kubeInformerFactory   informers.SharedInformerFactory
+	clock                 *clocktesting.FakeClock
+	replacementDelay      time.Duration
+	report                *report
+
+	pods               cache.Indexer
+	namespaces         cache.Indexer
+	evictionRequests   cache.Indexer
+	interceptorClasses cache.Indexer
+	evictionPolicies   cache.Indexer
+	evictionSchedules  cache.Indexer
+
+	// replacements are the pods replacing evicted pods once they are ready
+	replacements []*corev1.Pod
+	readyAt      map[types.UID]time.Time
+	sequence     int
+
+	// evicted holds the eviction time by pod UID
+	evicted map[types.UID]time.Time
+	// blocked holds the PodDisruptionBudget that rejected the last eviction of a pod and since when
+	blocked      map[types.UID]string
+	blockedSince map[types.UID]time.Time
+}
+
+// newCluster seeds the fake clientsets and listers with the snapshot objects
+func newCluster(objects []runtime.Object, clock *clocktesting.FakeClock, replacementDelay time.Duration, report *report) (*cluster, error) {
+	c := &cluster{
+		clock:              clock,
+		replacementDelay:   replacementDelay,
+		report:             report,
+		pods:               newIndexer(),
+		namespaces:         newIndexer(),
+		evictionRequests:   newIndexer(),
+		interceptorClasses: newIndexer(),
+		evictionPolicies:   newIndexer(),
+		evictionSchedules:  newIndexer(),
+		readyAt:            make(map[types.UID]time.Time),
+		evicted:            make(map[types.UID]time.Time),
+		blocked:            make(map[types.UID]string),
+		blockedSince:       make(map[types.UID]time.Time),
+	}
+
+	var kubeObjects, evictionRequestObjects []runtime.Object
+	namespaces := map[string]bool{}
+	for _, object := range objects {
+		c.setDefaults(object)
+		switch o := object.(type) {
+		case *v1alpha1.EvictionRequest:
+			evictionRequestObjects = append(evictionRequestObjects, o)
+			namespaces[o.Namespace] = true
+		case *v1alpha1.InterceptorClass:
+			evictionRequestObjects = append(evictionRequestObjects, o)
+			_ = c.interceptorClasses.Add(o)
+		case *v1alpha1.EvictionPolicy:
+			evictionRequestObjects = append(evictionRequestObjects, o)
+			_ = c.evictionPolicies.Add(o)
+		case *v1alpha1.EvictionSchedule:
+			evictionRequestObjects = append(evictionRequestObjects, o)
+			_ = c.evictionSchedules.Add(o)
+		case *corev1.Namespace:
+			kubeObjects = append(kubeObjects, o)
+			_ = c.namespaces.Add(o)
+		case *corev1.Pod, *corev1.Node, *policyv1.PodDisruptionBudget:
+			kubeObjects = append(kubeObjects, o)
+			if accessor, err := meta.Accessor(o); err == nil && accessor.GetNamespace() != "" {
+				namespaces[accessor.GetNamespace()] = true
+			}
+		default:
+			return nil, fmt.Errorf("unsupported snapshot object %T", object)
+		}
+	}
+	// Namespaces select eviction policies and schedules, the labels of missing ones are unknown
+	for namespace := range namespaces {
+		if _, ok, _ := c.namespaces.GetByKey(namespace); !ok {
+			ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: namespace}}
+			kubeObjects = append(kubeObjects, ns)
+			_ = c.namespaces.Add(ns)
+		}
+	}
+
+	c.kubeClient = kubefake.NewSimpleClientset(kubeObjects...)
+	c.evictionRequestClient = evreqfake.NewSimpleClientset(evictionRequestObjects...)
+	c.kubeClient.PrependReactor("create", "pods", c.evict)
+	// Nodes are only read through an informer, by eviction budgets per zone
+	c.kubeInformerFactory = informers.NewSharedInformerFactoryWithOptions(c.kubeClient, 0,
+		informers.WithTransform(informer.Trim),
+	)
+	return c, c.sync()
+}
+
+// setDefaults fills the fields the API server would set on the snapshot objects
+func (c *cluster) setDefaults(object runtime.Object) {
+	accessor, err := meta.Accessor(object)
+	if err != nil {
+		return
+	}
+	if accessor.GetUID() == "" {
+		accessor.SetUID(types.UID(fmt.Sprintf("%T/%s/%s", object, accessor.GetNamespace(), accessor.GetName())))
+	}
+	if creationTimestamp := accessor.GetCreationTimestamp(); creationTimestamp.IsZero() {
+		accessor.SetCreationTimestamp(metav1.NewTime(c.clock.Now()))
+	}
+
+	evictionRequest, ok := object.(*v1alpha1.EvictionRequest)
+	if !ok {
+		return
+	}
+	if evictionRequest.Spec.Type == "" {
+		evictionRequest.Spec.Type = v1alpha1.Soft
+	}
+	if evictionRequest.Spec.HeartbeatDeadlineSeconds == nil {
+		heartbeatDeadlineSeconds := int32(_defaultHeartbeatDeadlineSeconds)
+		evictionRequest.Spec.HeartbeatDeadlineSeconds = &heartbeatDeadlineSeconds
+	}
+}
+
+// sync adds the replacements that became ready and copies the pods and eviction requests of the fake
+// clientsets into the listers
+func (c *cluster) sync() error {
+	now := c.clock.Now()
+	var pending []*corev1.Pod
+	for _, pod := range c.replacements {
+		if now.Before(c.readyAt[pod.UID]) {
+			pending = append(pending, pod)
+			continue
+		}
+		if err := c.kubeClient.Tracker().Add(pod); err != nil {
+			return fmt.Errorf("failed to add replacement pod: %w", err)
+		}
+		c.report.event(pod, "Replacement pod ready")
+	}
+	c.replacements = pending
+
+	pods, err := c.kubeClient.Tracker().List(_podsResource, _podKind, "")
+	if err != nil {
+		return fmt.Errorf("failed to list pods: %w", err)
+	}
+	var items []interface{}
+	for i := range pods.(*corev1.PodList).Items {
+		items = append(items, &pods.(*corev1.PodList).Items[i])
+	}
+	if err := c.pods.Replace(items, ""); err != nil {
+		return err
+	}
+
+	evictionRequests, err := c.evictionRequestClient.EvictionrequestV1alpha1().EvictionRequests("").List(context.Background(), metav1.ListOptions{})
+	if err != nil {
+		return fmt.Errorf("failed to list eviction requests: %w", err)
+	}
+	items = items[:0]
+	for i := range evictionRequests.Items {
+		items = append(items, &evictionRequests.Items[i])
+	}
+	return c.evictionRequests.Replace(items, "")
+}
+
+// refresh copies the current state of an eviction request into the lister
+func (c *cluster) refresh(ctx context.Context, namespace, name string) (*v1alpha1.EvictionRequest, error) {
+	evictionRequest, err := c.evictionRequestClient.EvictionrequestV1alpha1().EvictionRequests(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	return evictionRequest, c.evictionRequests.Update(evictionRequest)
+}
+
+// evictAll creates an eviction request for every pod that no active eviction request targets, with the
+// defaults of the eviction policies of its namespace
+func (c *cluster) evictAll(ctx context.Context, requester string) error {
+	all, err := c.podLister().List(labels.Everything())
+	if err != nil {
+		return err
+	}
+	sort.Slice(all, func(i, j int) bool {
+		return cache.MetaObjectToName(all[i]).String() < cache.MetaObjectToName(all[j]).String()
+	})
+
+	for _, pod := range all {
+		evictionRequests, err := c.evictionRequestLister().EvictionRequests(pod.Namespace).List(labels.Everything())
+		if err != nil {
+			return err
+		}
+		if len(duplicate.Active(evictionRequests, string(pod.UID))) > 0 {
+			continue
+		}
+		policy, err := evictionpolicy.Resolve(c.evictionPolicyLister(), c.namespaceLister(), pod.Namespace, zap.NewNop())
+		if err != nil {
+			return err
+		}
+
+		heartbeatDeadlineSeconds := int32(_defaultHeartbeatDeadlineSeconds)
+		if policy.DefaultHeartbeatDeadlineSeconds != nil {
+			heartbeatDeadlineSeconds = *policy.DefaultHeartbeatDeadlineSeconds
+		}
+		name := pod.Name
+		if _, err := c.evictionRequestLister().EvictionRequests(pod.Namespace).Get(name); err == nil {
+			name += "-simulated"
+		}
+		evictionRequest := &v1alpha1.EvictionRequest{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: pod.Namespace},
+			Spec: v1alpha1.EvictionRequestSpec{
+				Target: v1alpha1.EvictionTarget{
+					PodRef: &v1alpha1.LocalPodReference{Name: pod.Name, UID: string(pod.UID)},
+				},
+				Requesters:                []v1alpha1.Requester{{Name: requester}},
+				Interceptors:              policy.DefaultInterceptors,
+				HeartbeatDeadlineSeconds:  &heartbeatDeadlineSeconds,
+				TTLSecondsAfterCompletion: policy.TTLSecondsAfterCompletion,
+			},
+		}
+		c.setDefaults(evictionRequest)
+		if _, err := c.evictionRequestClient.EvictionrequestV1alpha1().EvictionRequests(pod.Namespace).Create(ctx, evictionRequest, metav1.CreateOptions{}); err != nil {
+			return fmt.Errorf("failed to create eviction request for pod %s/%s: %w", pod.Namespace, pod.Name, err)
+		}
+		if _, err := c.refresh(ctx, pod.Namespace, name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// evict emulates the Eviction API: the pod is deleted unless a PodDisruptionBudget selecting it has no
+// disruptions left. Evicted pods owned by a controller are replaced after the replacement delay.
+func (c *cluster) evict(action k8stesting.Action) (bool, runtime.Object, error) {
+	if action.GetSubresource() != "eviction" {
+		return false, nil, nil
+	}
+	create, ok := action.(k8stesting.CreateAction)
+	if !ok {
+		return true, nil, errors.New("unexpected eviction action")
+	}
+	eviction, err := meta.Accessor(create.GetObject())
+	if err != nil {
+		return true, nil, err
+	}
+
+	object, err := c.kubeClient.Tracker().Get(_podsResource, action.GetNamespace(), eviction.GetName())
+	if err != nil {
+		return true, nil, err
+	}
+	pod := object.(*corev1.Pod)
+
+	pdb, err := c.violatedBudget(pod)
+	if err != nil {
+		return true, nil, err
+	}
+	if pdb != "" {
+		if c.blocked[pod.UID] != pdb {
+			c.report.event(pod, fmt.Sprintf("Eviction blocked by PodDisruptionBudget %s", pdb))
+			c.blockedSince[pod.UID] = c.clock.Now()
+		}
+		c.blocked[pod.UID] = pdb
+		return true, nil, apierrors.NewTooManyRequests("Cannot evict pod as it would violate the pod's disruption budget.", 10)
+	}
+
+	if err := c.kubeClient.Tracker().Delete(_podsResource, pod.Namespace, pod.Name); err != nil {
+		return true, nil, err
+	}
+	_ = c.pods.Delete(pod)
+	delete(c.blocked, pod.UID)
+	delete(c.blockedSince, pod.UID)
+	c.evicted[pod.UID] = c.clock.Now()
+	c.report.event(pod, "Pod evicted")
+	c.replace(pod)
+	return true, nil, nil
+}
+
+// violatedBudget returns the name of a PodDisruptionBudget that does not allow the eviction of the pod
+func (c *cluster) violatedBudget(pod *corev1.Pod) (string, error) {
+	object, err := c.kubeClient.Tracker().List(_pdbsResource, _pdbKind, pod.Namespace)
+	if err != nil {
+		return "", err
+	}
+
+	for _, pdb := range object.(*policyv1.PodDisruptionBudgetList).Items {
+		selector, err := metav1.LabelSelectorAsSelector(pdb.Spec.Selector)
+		if err != nil || selector.Empty() || !selector.Matches(labels.Set(pod.Labels)) {
+			continue
+		}
+
+		pods, err := c.podLister().Pods(pod.Namespace).List(selector)
+		if err != nil {
+			return "", err
+		}
+		var expected, healthy int
+		for _, other := range pods {
+			expected++
+			if other.DeletionTimestamp == nil && (other.Status.Phase == corev1.PodRunning || other.Status.Phase == "") {
+				healthy++
+			}
+		}
+		// Pods that are being replaced still count towards the expected pods of their controller
+		for _, replacement := range c.replacements {
+			if replacement.Namespace == pod.Namespace && selector.Matches(labels.Set(replacement.Labels)) {
+				expected++
+			}
+		}
+
+		desired := 0
+		switch {
+		case pdb.Spec.MinAvailable != nil:
+			desired, err = intstr.GetScaledValueFromIntOrPercent(pdb.Spec.MinAvailable, expected, true)
+		case pdb.Spec.MaxUnavailable != nil:
+			var maxUnavailable int
+			maxUnavailable, err = intstr.GetScaledValueFromIntOrPercent(pdb.Spec.MaxUnavailable, expected, true)
+			desired = expected - maxUnavailable
+		}
+		if err != nil {
+			return "", fmt.Errorf("invalid PodDisruptionBudget %s/%s: %w", pdb.Namespace, pdb.Name, err)
+		}
+		if healthy-desired < 1 {
+			return pdb.Name, nil
+		}
+	}
+	return "", nil
+}
+
+// replace schedules a ready replacement for an evicted pod owned by a controller. StatefulSet pods keep their
+// name, others get a new one.
+func (c *cluster) replace(pod *corev1.Pod) {
+	owner := metav1.GetControllerOf(pod)
+	if owner == nil {
+		return
+	}
+
+	c.sequence++
+	name := fmt.Sprintf("%s-replacement-%d", pod.Name, c.sequence)
+	if owner.Kind == "StatefulSet" {
+		name = pod.Name
+	} else if pod.GenerateName != "" {
+		name = fmt.Sprintf("%sreplacement-%d", pod.GenerateName, c.sequence)
+	}
+	replacement := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              name,
+			Namespace:         pod.Namespace,
+			GenerateName:      pod.GenerateName,
+			UID:               types.UID(fmt.Sprintf("replacement-%d", c.sequence)),
+			Labels:            pod.Labels,
+			OwnerReferences:   pod.OwnerReferences,
+			CreationTimestamp: metav1.NewTime(c.clock.Now()),
+		},
+		Status: corev1.PodStatus{Phase: corev1.PodRunning},
+	}
+	c.replacements = append(c.replacements, replacement)
+	c.readyAt[replacement.UID] = c.clock.Now().Add(c.replacementDelay)
+}
+
+// nextReplacement returns when the next replacement pod becomes ready, and false if none is pending
+func (c *cluster) nextReplacement() (time.Time, bool) {
+	var next time.Time
+	for _, pod := range c.replacements {
+		if at := c.readyAt[pod.UID]; next.IsZero() || at.Before(next) {
+			next = at
+		}
+	}
+	return next, !next.IsZero()
+}
+
+// keepAlive renews the heartbeat of the interceptor classes implemented by a behavior, so that they are
+// selected. Classes without a behavior keep the liveness of the snapshot.
+func (c *cluster) keepAlive(behaviors map[string]behavior) {
+	now := metav1.NewTime(c.clock.Now())
+	for _, object := range c.interceptorClasses.List() {
+		class := object.(*v1alpha1.InterceptorClass)
+		if _, ok := behaviors[class.Name]; !ok {
+			continue
+		}
+		class = class.DeepCopy()
+		class.Status.HeartbeatTime = &now
+		_ = c.interceptorClasses.Update(class)
+	}
+}
+
+func (c *cluster) podLister() corev1listers.PodLister {
+	return corev1listers.NewPodLister(c.pods)
+}
+
+func (c *cluster) namespaceLister() corev1listers.NamespaceLister {
+	return corev1listers.NewNamespaceLister(c.namespaces)
+}
+
+func (c *cluster) evictionRequestLister() evreqlisters.EvictionRequestLister {
+	return evreqlisters.NewEvictionRequestLister(c.evictionRequests)
+}
+
+func (c *cluster) interceptorClassLister() evreqlisters.InterceptorClassLister {
+	return evreqlisters.NewInterceptorClassLister(c.interceptorClasses)
+}
+
+func (c *cluster) evictionPolicyLister() evreqlisters.EvictionPolicyLister {
+	return evreqlisters.NewEvictionPolicyLister(c.evictionPolicies)
+}
+
+func (c *cluster) evictionScheduleLister() evreqlisters.EvictionScheduleLister {
+	return evreqlisters.NewEvictionScheduleLister(c.evictionSchedules)
+}
+
+// podUID returns the UID of the pod referenced by an eviction request
+func podUID(podRef *v1alpha1.LocalPodReference) types.UID {
+	return types.UID(podRef.UID)
+}
+
+func newIndexer() cache.Indexer {
+	return cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+}
@@ -0,0 +1,111 @@
+// Command simulate replays an eviction plan offline. It loads a snapshot of pods, PodDisruptionBudgets and
+// EvictionRequests, runs the real reconciler against fake clients with a virtual clock and scripted interceptor
+// behaviors, and prints a timeline and a summary of the evictions that remained blocked.
+//
+//	simulate --snapshot snapshot.yaml [--behaviors behaviors.yaml] [--evict-all] [--max-duration 24h]
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/spf13/pflag"
+	"k8s.io/klog/v2"
+	clocktesting "k8s.io/utils/clock/testing"
+)
+
+// options holds the flags of the simulation
+type options struct {
+	snapshot         string
+	behaviors        string
+	evictAll         bool
+	requester        string
+	start            string
+	maxDuration      time.Duration
+	replacementDelay time.Duration
+	quiet            bool
+}
+
+func main() {
+	o := &options{}
+	fs := pflag.NewFlagSet("simulate", pflag.ContinueOnError)
+	fs.StringVar(&o.snapshot, "snapshot", "", "Path of the snapshot: YAML documents or lists of Pods, PodDisruptionBudgets, Nodes, Namespaces, EvictionRequests, InterceptorClasses, EvictionPolicies and EvictionSchedules (required)")
+	fs.StringVar(&o.behaviors, "behaviors", "", "Path of the scripted interceptor behaviors. Interceptors without a behavior never respond")
+	fs.BoolVar(&o.evictAll, "evict-all", false, "Create an EvictionRequest for every pod of the snapshot that is not targeted yet")
+	fs.StringVar(&o.requester, "requester", "simulate.evictionrequest.coordination.uber.com", "Requester of the EvictionRequests created by --evict-all")
+	fs.StringVar(&o.start, "start", "", "Start time of the simulation as RFC 3339 (defaults to now), relevant for maintenance windows")
+	fs.DurationVar(&o.maxDuration, "max-duration", 24*time.Hour, "Simulated time after which the simulation stops")
+	fs.DurationVar(&o.replacementDelay, "replacement-delay", time.Minute, "Time until an evicted pod owned by a controller is replaced by a ready pod")
+	fs.BoolVar(&o.quiet, "quiet", false, "Only print the summary")
+
+	if err := fs.Parse(os.Args[1:]); err != nil {
+		if err == pflag.ErrHelp {
+			return
+		}
+		os.Exit(2)
+	}
+	if o.snapshot == "" {
+		fmt.Fprintln(os.Stderr, "error: --snapshot is required")
+		fs.PrintDefaults()
+		os.Exit(2)
+	}
+
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer cancel()
+
+	if err := run(ctx, o, os.Stdout); err != nil {
+		fmt.Fprintf(os.Stderr, "error: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+// run loads the inputs, simulates the eviction plan and prints the report to w
+func run(ctx context.Context, o *options, w io.Writer) error {
+	start := time.Now()
+	if o.start != "" {
+		var err error
+		if start, err = time.Parse(time.RFC3339, o.start); err != nil {
+			return fmt.Errorf("invalid --start: %w", err)
+		}
+	}
+
+	objects, err := loadSnapshot(o.snapshot)
+	if err != nil {
+		return err
+	}
+	behaviors, err := loadBehaviors(o.behaviors)
+	if err != nil {
+		return err
+	}
+
+	// The worker pool logs failed reconciles, the timeline already shows them
+	klog.SetLogger(logr.Discard())
+
+	clock := clocktesting.NewFakeClock(start)
+	report := newReport(w, clock, o.quiet)
+	c, err := newCluster(objects, clock, o.replacementDelay, report)
+	if err != nil {
+		return err
+	}
+	s, err := newSimulator(ctx, c, behaviors, report)
+	if err != nil {
+		return err
+	}
+	defer s.stop()
+
+	if o.evictAll {
+		if err := c.evictAll(ctx, o.requester); err != nil {
+			return err
+		}
+	}
+	if err := s.run(ctx, start.Add(o.maxDuration)); err != nil {
+		return err
+	}
+	report.summary(c)
+	return nil
+}
@@ -0,0 +1,47 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+var update = flag.Bool("update", false, "Update the expected timelines in testdata")
+
+// TestSnapshotTimeline simulates the scripted snapshot of testdata and compares the timeline with the expected
+// one. A pod disruption budget lets one api pod go at a time, and the batch pod waits for a checkpointing
+// interceptor and then for the deadline of an interceptor that stalls.
+func TestSnapshotTimeline(t *testing.T) {
+	o := &options{
+		snapshot:         filepath.Join("testdata", "snapshot.yaml"),
+		behaviors:        filepath.Join("testdata", "behaviors.yaml"),
+		evictAll:         true,
+		requester:        "simulate.evictionrequest.coordination.uber.com",
+		start:            "2026-10-18T22:00:00Z",
+		maxDuration:      24 * time.Hour,
+		replacementDelay: time.Minute,
+	}
+
+	var timeline bytes.Buffer
+	if err := run(context.Background(), o, &timeline); err != nil {
+		t.Fatalf("run() error = %v", err)
+	}
+
+	golden := filepath.Join("testdata", "timeline.txt")
+	if *update {
+		if err := os.WriteFile(golden, timeline.Bytes(), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	expected, err := os.ReadFile(golden)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(timeline.Bytes(), expected) {
+		t.Errorf("timeline differs from %s, run go test ./cmd/simulate -update to accept it:\n%s", golden, timeline.String())
+	}
+}
@@ -0,0 +1,85 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/utils/clock"
+)
+
+// virtualQueue is the delaying queue of the worker pool in virtual time. Items added with a delay are held until
+// the simulator moves the clock to their deadline and releases them, instead of waiting on timers.
+type virtualQueue struct {
+	workqueue.TypedInterface[any]
+
+	clock clock.PassiveClock
+	// scheduled holds when delayed items are added to the queue, by item
+	scheduled map[any]time.Time
+}
+
+func newVirtualQueue(clock clock.PassiveClock) *virtualQueue {
+	return &virtualQueue{
+		TypedInterface: workqueue.NewTyped[any](),
+		clock:          clock,
+		scheduled:      make(map[any]time.Time),
+	}
+}
+
+// AddAfter adds the item once the delay passed. Like the delaying queue of client-go, an item that is already
+// waiting keeps the earlier deadline.
+func (q *virtualQueue) AddAfter(item any, duration time.Duration) {
+	if duration <= 0 {
+		q.Add(item)
+		return
+	}
+	at := q.clock.Now().Add(duration + _requeueLatency)
+	if scheduled, ok := q.scheduled[item]; ok && !at.Before(scheduled) {
+		return
+	}
+	q.scheduled[item] = at
+}
+
+// release adds the items whose deadline passed to the queue, by deadline and then by item so that simulations
+// are reproducible
+func (q *virtualQueue) release() {
+	now := q.clock.Now()
+	var due []any
+	for item, at := range q.scheduled {
+		if !now.Before(at) {
+			due = append(due, item)
+		}
+	}
+	sort.Slice(due, func(i, j int) bool {
+		if !q.scheduled[due[i]].Equal(q.scheduled[due[j]]) {
+			return q.scheduled[due[i]].Before(q.scheduled[due[j]])
+		}
+		return fmt.Sprint(due[i]) < fmt.Sprint(due[j])
+	})
+	for _, item := range due {
+		delete(q.scheduled, item)
+		q.Add(item)
+	}
+}
+
+// next returns the earliest deadline of the delayed items, and false if no item is delayed
+func (q *virtualQueue) next() (time.Time, bool) {
+	var next time.Time
+	for _, at := range q.scheduled {
+		if next.IsZero() || at.Before(next) {
+			next = at
+		}
+	}
+	return next, !next.IsZero()
+}
+
+// retryRateLimiter backs off failed eviction requests per item like the worker pool, but retries at least every
+// _retryInterval
+type retryRateLimiter struct {
+	workqueue.TypedRateLimiter[any]
+}
+
+func (l retryRateLimiter) When(item any) time.Duration {
+	return min(l.TypedRateLimiter.When(item), _retryInterval)
+}
@@ -0,0 +1,179 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"text/tabwriter"
+	"time"
+
+	"code.uber.internal/apis/evictionrequest/v1alpha1"
+	"code.uber.internal/pkg/constants"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/clock"
+)
+
+// report prints the timeline of the simulation and its summary. It records the events of the reconciler.
+type report struct {
+	w     io.Writer
+	clock clock.PassiveClock
+	start time.Time
+	quiet bool
+}
+
+func newReport(w io.Writer, clock clock.PassiveClock, quiet bool) *report {
+	return &report{w: w, clock: clock, start: clock.Now(), quiet: quiet}
+}
+
+// event prints a timeline entry about an object at the current simulated time
+func (r *report) event(object runtime.Object, message string) {
+	if r.quiet {
+		return
+	}
+	fmt.Fprintf(r.w, "%-10s  %-50s  %s\n", r.offset(r.clock.Now()), objectName(object), message)
+}
+
+// Event records an event of the reconciler in the timeline
+func (r *report) Event(object runtime.Object, eventtype, reason, message string) {
+	r.event(object, fmt.Sprintf("Event %s %s: %s", eventtype, reason, message))
+}
+
+// Eventf records an event of the reconciler in the timeline
+func (r *report) Eventf(object runtime.Object, eventtype, reason, messageFmt string, args ...interface{}) {
+	r.Event(object, eventtype, reason, fmt.Sprintf(messageFmt, args...))
+}
+
+// AnnotatedEventf records an event of the reconciler in the timeline
+func (r *report) AnnotatedEventf(object runtime.Object, _ map[string]string, eventtype, reason, messageFmt string, args ...interface{}) {
+	r.Eventf(object, eventtype, reason, messageFmt, args...)
+}
+
+// transition prints the changes of an eviction request made by a reconcile or an interceptor
+func (r *report) transition(before, after *v1alpha1.EvictionRequest) {
+	if class := after.Status.ActiveInterceptorClass; class != nil &&
+		(before.Status.ActiveInterceptorClass == nil || *before.Status.ActiveInterceptorClass != *class) {
+		r.event(after, fmt.Sprintf("Interceptor %s selected", *class))
+	}
+	for i, entry := range after.Status.InterceptorHistory {
+		if entry.CompletionTime == nil {
+			continue
+		}
+		if i < len(before.Status.InterceptorHistory) && before.Status.InterceptorHistory[i].CompletionTime != nil {
+			continue
+		}
+		r.event(after, fmt.Sprintf("Interceptor %s finished: %s", entry.InterceptorClass, entry.Reason))
+	}
+	for _, condition := range after.Status.Conditions {
+		previous := meta.FindStatusCondition(before.Status.Conditions, condition.Type)
+		if previous != nil && previous.Status == condition.Status && previous.Reason == condition.Reason {
+			continue
+		}
+		r.event(after, fmt.Sprintf("Condition %s=%s (%s): %s", condition.Type, condition.Status, condition.Reason, condition.Message))
+	}
+}
+
+// summary prints the evicted pods and the eviction requests that are still blocked
+func (r *report) summary(c *cluster) {
+	evictionRequests, _ := c.evictionRequestLister().List(labels.Everything())
+	sort.Slice(evictionRequests, func(i, j int) bool {
+		return objectName(evictionRequests[i]) < objectName(evictionRequests[j])
+	})
+
+	var evicted []time.Duration
+	var completed int
+	var blocked []*v1alpha1.EvictionRequest
+	for _, evictionRequest := range evictionRequests {
+		podRef := evictionRequest.Spec.Target.PodRef
+		if podRef == nil {
+			continue
+		}
+		if at, ok := c.evicted[podUID(podRef)]; ok {
+			evicted = append(evicted, at.Sub(r.start))
+			continue
+		}
+		if meta.IsStatusConditionTrue(evictionRequest.Status.Conditions, string(v1alpha1.EvictionRequestComplete)) || len(evictionRequest.Spec.Requesters) == 0 {
+			completed++
+			continue
+		}
+		blocked = append(blocked, evictionRequest)
+	}
+	sort.Slice(evicted, func(i, j int) bool { return evicted[i] < evicted[j] })
+
+	w := tabwriter.NewWriter(r.w, 0, 8, 2, ' ', 0)
+	fmt.Fprintf(w, "\nSummary after %s\n", r.offset(r.clock.Now()))
+	fmt.Fprintf(w, "  Eviction requests:\t%d\n", len(evictionRequests))
+	if len(evicted) > 0 {
+		fmt.Fprintf(w, "  Pods evicted:\t%d (first %s, median %s, last %s)\n", len(evicted),
+			offset(evicted[0]), offset(evicted[len(evicted)/2]), offset(evicted[len(evicted)-1]))
+	} else {
+		fmt.Fprintf(w, "  Pods evicted:\t0\n")
+	}
+	fmt.Fprintf(w, "  Completed without eviction:\t%d\n", completed)
+	fmt.Fprintf(w, "  Blocked:\t%d\n", len(blocked))
+	_ = w.Flush()
+
+	if len(blocked) == 0 {
+		return
+	}
+	w = tabwriter.NewWriter(r.w, 0, 8, 3, ' ', 0)
+	fmt.Fprintf(w, "\nBlocked evictions\n")
+	fmt.Fprintf(w, "EVICTIONREQUEST\tPOD\tREASON\n")
+	for _, evictionRequest := range blocked {
+		fmt.Fprintf(w, "%s/%s\t%s\t%s\n", evictionRequest.Namespace, evictionRequest.Name,
+			evictionRequest.Spec.Target.PodRef.Name, r.blockedReason(c, evictionRequest))
+	}
+	_ = w.Flush()
+}
+
+// blockedReason explains why the pod of an eviction request was not evicted
+func (r *report) blockedReason(c *cluster, evictionRequest *v1alpha1.EvictionRequest) string {
+	uid := podUID(evictionRequest.Spec.Target.PodRef)
+	if pdb, ok := c.blocked[uid]; ok {
+		return fmt.Sprintf("PodDisruptionBudget %s (since %s)", pdb, r.offset(c.blockedSince[uid]))
+	}
+	status := evictionRequest.Status
+	if status.ActiveInterceptorClass != nil && !status.ActiveInterceptorCompleted {
+		return fmt.Sprintf("Waiting for interceptor %s", *status.ActiveInterceptorClass)
+	}
+	for _, conditionType := range []string{
+		constants.ConditionTypeWaitingForEvictionWindow,
+		constants.ConditionTypeWaitingForEvictionBudget,
+		constants.ConditionTypeDuplicate,
+	} {
+		if condition := meta.FindStatusCondition(status.Conditions, conditionType); condition != nil && condition.Status == "True" {
+			return fmt.Sprintf("%s: %s", condition.Reason, condition.Message)
+		}
+	}
+	if condition := meta.FindStatusCondition(status.Conditions, constants.ConditionTypeEvicted); condition != nil && condition.Status == "False" {
+		return fmt.Sprintf("%s: %s", condition.Reason, condition.Message)
+	}
+	return "Not processed"
+}
+
+// offset formats the time since the start of the simulation
+func (r *report) offset(t time.Time) string {
+	return offset(t.Sub(r.start))
+}
+
+func offset(d time.Duration) string {
+	return "+" + d.Round(time.Second).String()
+}
+
+// objectName returns the kind and namespaced name of a timeline object
+func objectName(object runtime.Object) string {
+	accessor, err := meta.Accessor(object)
+	if err != nil {
+		return fmt.Sprintf("%T", object)
+	}
+	kind := "object"
+	switch object.(type) {
+	case *corev1.Pod:
+		kind = "pod"
+	case *v1alpha1.EvictionRequest:
+		kind = "evictionrequest"
+	}
+	return fmt.Sprintf("%s/%s/%s", kind, accessor.GetNamespace(), accessor.GetName())
+}
@@ -0,0 +1,332 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"code.uber.internal/apis/evictionrequest/v1alpha1"
+	"code.uber.internal/pkg/config"
+	"code.uber.internal/pkg/constants"
+	"code.uber.internal/pkg/duplicate"
+	"code.uber.internal/pkg/generated/clientset/versioned"
+	"code.uber.internal/pkg/reconciler"
+	"code.uber.internal/pkg/reconciler/requeue"
+	"code.uber.internal/pkg/worker"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/utils/clock"
+)
+
+const (
+	// _maxPasses bounds the reconciles of a simulated instant, in case eviction requests keep changing
+	_maxPasses = 100
+	// _minStep is the time the clock advances when an instant does not settle
+	_minStep = time.Second
+	// _baseBackoff and _maxBackoff are the per-item backoff of the worker pool after failed reconciles
+	_baseBackoff = 5 * time.Millisecond
+	_maxBackoff  = 1000 * time.Second
+	// _retryInterval is how soon an eviction request is retried after a failed reconcile changed it. The worker pool
+	// picks up such a change right away and retries as fast as the API server answers; the simulator retries at this
+	// pace instead so that pods blocked by disruption budgets do not keep an instant from settling.
+	_retryInterval = 5 * time.Second
+	// _requeueLatency delays requested requeues: the worker pool never picks them up exactly at their deadline
+	_requeueLatency = time.Millisecond
+)
+
+// simulator drives the worker pool and the real reconciler in virtual time. Like the controller, it enqueues an
+// eviction request when it changed, and all of them on every resync; the worker pool requeues them when requested
+// and after failures with exponential backoff. Between those instants the clock jumps ahead.
+type simulator struct {
+	cluster    *cluster
+	behaviors  map[string]behavior
+	report     *report
+	app        *fx.App
+	reconciler reconciler.Interface
+	worker     worker.Interface
+	queue      *virtualQueue
+	workqueue  workqueue.RateLimitingInterface
+
+	// observed holds the eviction requests as they were last reconciled, by key
+	observed map[string]*v1alpha1.EvictionRequest
+	resyncAt time.Time
+	// err is the first error of the simulated cluster during a reconcile
+	err error
+}
+
+// newSimulator builds the worker pool and the reconciler of the eviction request controller on top of the
+// simulated cluster
+func newSimulator(ctx context.Context, c *cluster, behaviors map[string]behavior, report *report) (*simulator, error) {
+	options, err := config.NewOptions()
+	if err != nil {
+		return nil, err
+	}
+
+	s := &simulator{
+		cluster:   c,
+		behaviors: behaviors,
+		report:    report,
+		queue:     newVirtualQueue(c.clock),
+		observed:  make(map[string]*v1alpha1.EvictionRequest),
+		resyncAt:  c.clock.Now().Add(constants.DefaultResyncInterval),
+	}
+	s.workqueue = workqueue.NewTypedRateLimitingQueueWithConfig[any](
+		retryRateLimiter{workqueue.NewTypedItemExponentialFailureRateLimiter[any](_baseBackoff, _maxBackoff)},
+		workqueue.TypedRateLimitingQueueConfig[any]{DelayingQueue: s.queue},
+	)
+	s.app = fx.New(
+		fx.NopLogger,
+		reconciler.Module,
+		fx.Supply(options, zap.NewNop()),
+		fx.Provide(
+			func() kubernetes.Interface { return c.kubeClient },
+			func() versioned.Interface { return c.evictionRequestClient },
+			func() informers.SharedInformerFactory { return c.kubeInformerFactory },
+			func() clock.Clock { return c.clock },
+			func() record.EventRecorder { return report },
+			func() workqueue.RateLimitingInterface { return s.workqueue },
+			c.podLister,
+			c.namespaceLister,
+			c.evictionRequestLister,
+			c.interceptorClassLister,
+			c.evictionPolicyLister,
+			c.evictionScheduleLister,
+		),
+		// The worker pool reconciles through the simulator, which reports the changes of every reconcile
+		fx.Module("worker",
+			fx.Provide(worker.New),
+			fx.Decorate(func(r reconciler.Interface) reconciler.Interface {
+				s.reconciler = r
+				return s
+			}),
+		),
+		fx.Populate(&s.worker),
+	)
+	if err := s.app.Start(ctx); err != nil {
+		return nil, fmt.Errorf("failed to build the reconciler: %w", err)
+	}
+
+	c.kubeInformerFactory.Start(ctx.Done())
+	c.kubeInformerFactory.WaitForCacheSync(ctx.Done())
+	return s, nil
+}
+
+// stop stops the reconciler
+func (s *simulator) stop() {
+	s.workqueue.ShutDown()
+	_ = s.app.Stop(context.Background())
+}
+
+// run simulates until no eviction request is active or the end is reached
+func (s *simulator) run(ctx context.Context, end time.Time) error {
+	for passes := 0; ; passes++ {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if err := s.cluster.sync(); err != nil {
+			return err
+		}
+		s.cluster.keepAlive(s.behaviors)
+		if err := s.intercept(ctx); err != nil {
+			return err
+		}
+		if err := s.reconcile(ctx); err != nil {
+			return err
+		}
+		if !s.active() {
+			return nil
+		}
+
+		now := s.cluster.clock.Now()
+		next := s.next()
+		if !next.After(now) {
+			if passes < _maxPasses {
+				continue
+			}
+			next = now.Add(_minStep)
+		}
+		if next.After(end) {
+			s.cluster.clock.SetTime(end)
+			return nil
+		}
+		s.cluster.clock.SetTime(next)
+		passes = 0
+
+		if !next.Before(s.resyncAt) {
+			for _, evictionRequest := range s.evictionRequests() {
+				s.worker.Enqueue(evictionRequest)
+			}
+			s.resyncAt = next.Add(constants.DefaultResyncInterval)
+		}
+	}
+}
+
+// intercept lets the scripted behaviors act on the eviction requests of their interceptor classes
+func (s *simulator) intercept(ctx context.Context) error {
+	now := s.cluster.clock.Now()
+	for _, evictionRequest := range s.evictionRequests() {
+		b, entry, ok := s.activeBehavior(evictionRequest)
+		if !ok {
+			continue
+		}
+		if at, ok := b.next(&evictionRequest.Status, entry); !ok || now.Before(at) {
+			continue
+		}
+
+		updated := evictionRequest.DeepCopy()
+		b.act(&updated.Status, &updated.Status.InterceptorHistory[len(updated.Status.InterceptorHistory)-1], now)
+		if _, err := s.cluster.evictionRequestClient.EvictionrequestV1alpha1().EvictionRequests(updated.Namespace).UpdateStatus(ctx, updated, metav1.UpdateOptions{}); err != nil {
+			return fmt.Errorf("failed to update eviction request status: %w", err)
+		}
+		if _, err := s.cluster.refresh(ctx, updated.Namespace, updated.Name); err != nil {
+			return err
+		}
+		switch {
+		case updated.Status.ActiveInterceptorCompleted:
+			s.report.event(updated, fmt.Sprintf("Interceptor %s completed", entry.InterceptorClass))
+		case evictionRequest.Status.HeartbeatTime == nil:
+			s.report.event(updated, fmt.Sprintf("Interceptor %s adopted the eviction request", entry.InterceptorClass))
+		}
+	}
+	return nil
+}
+
+// activeBehavior returns the behavior of the active interceptor of an eviction request with its history entry
+func (s *simulator) activeBehavior(evictionRequest *v1alpha1.EvictionRequest) (behavior, *v1alpha1.InterceptorHistoryEntry, bool) {
+	status := &evictionRequest.Status
+	if !duplicate.IsActive(evictionRequest) || status.ActiveInterceptorClass == nil || status.ActiveInterceptorCompleted || len(status.InterceptorHistory) == 0 {
+		return behavior{}, nil, false
+	}
+	entry := &status.InterceptorHistory[len(status.InterceptorHistory)-1]
+	if entry.InterceptorClass != *status.ActiveInterceptorClass || entry.CompletionTime != nil {
+		return behavior{}, nil, false
+	}
+	b, ok := s.behaviors[entry.InterceptorClass]
+	return b, entry, ok
+}
+
+// reconcile lets the worker pool reconcile the eviction requests that are due until the current instant settles
+func (s *simulator) reconcile(ctx context.Context) error {
+	for pass := 0; pass < _maxPasses; pass++ {
+		s.queue.release()
+		for _, evictionRequest := range s.changed() {
+			s.worker.Enqueue(evictionRequest)
+		}
+		if s.workqueue.Len() == 0 {
+			return nil
+		}
+		for s.workqueue.Len() > 0 {
+			s.worker.Process(ctx)
+		}
+		if s.err != nil {
+			return s.err
+		}
+		if err := s.cluster.sync(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// changed returns the eviction requests that changed since their last reconcile, which the controller enqueues
+// on their update events
+func (s *simulator) changed() []*v1alpha1.EvictionRequest {
+	var changed []*v1alpha1.EvictionRequest
+	for _, evictionRequest := range s.evictionRequests() {
+		key := cache.MetaObjectToName(evictionRequest).String()
+		if observed, ok := s.observed[key]; !ok || !equality.Semantic.DeepEqual(observed, evictionRequest) {
+			changed = append(changed, evictionRequest)
+		}
+	}
+	return changed
+}
+
+// ReconcileEvictionRequest reconciles an eviction request for the worker pool with the real reconciler, then
+// reads back its writes into the simulated cluster and reports them
+func (s *simulator) ReconcileEvictionRequest(ctx context.Context, evictionRequest *v1alpha1.EvictionRequest) error {
+	key := cache.MetaObjectToName(evictionRequest).String()
+	before := evictionRequest.DeepCopy()
+	s.observed[key] = before
+
+	reconcileErr := s.reconciler.ReconcileEvictionRequest(ctx, evictionRequest)
+
+	updated, err := s.cluster.refresh(ctx, before.Namespace, before.Name)
+	if apierrors.IsNotFound(err) {
+		delete(s.observed, key)
+		if err := s.cluster.evictionRequests.Delete(before); err != nil && s.err == nil {
+			s.err = err
+		}
+		return reconcileErr
+	}
+	if err != nil {
+		if s.err == nil {
+			s.err = err
+		}
+		return reconcileErr
+	}
+	if _, ok := requeue.Is(reconcileErr); reconcileErr != nil && !ok {
+		// The retry is scheduled, the write of the failed reconcile does not trigger another one
+		s.observed[key] = updated.DeepCopy()
+	}
+	s.report.transition(before, updated)
+	return reconcileErr
+}
+
+// next returns the next instant something happens: a requeue, an interceptor action, a replacement pod
+// becoming ready or the next resync
+func (s *simulator) next() time.Time {
+	now := s.cluster.clock.Now()
+	next := s.resyncAt
+	consider := func(t time.Time) {
+		if t.Before(next) {
+			next = t
+		}
+	}
+
+	if at, ok := s.queue.next(); ok {
+		consider(at)
+	}
+	for _, evictionRequest := range s.evictionRequests() {
+		if b, entry, ok := s.activeBehavior(evictionRequest); ok {
+			if at, ok := b.next(&evictionRequest.Status, entry); ok {
+				consider(at)
+			}
+		}
+	}
+	if at, ok := s.cluster.nextReplacement(); ok {
+		consider(at)
+	}
+	if next.Before(now) {
+		return now
+	}
+	return next
+}
+
+// active reports whether any eviction request still asks for the eviction of its pod
+func (s *simulator) active() bool {
+	for _, evictionRequest := range s.evictionRequests() {
+		if duplicate.IsActive(evictionRequest) {
+			return true
+		}
+	}
+	return false
+}
+
+// evictionRequests returns the eviction requests of the lister ordered by key
+func (s *simulator) evictionRequests() []*v1alpha1.EvictionRequest {
+	evictionRequests, _ := s.cluster.evictionRequestLister().List(labels.Everything())
+	sort.Slice(evictionRequests, func(i, j int) bool {
+		return cache.MetaObjectToName(evictionRequests[i]).String() < cache.MetaObjectToName(evictionRequests[j]).String()
+	})
+	return evictionRequests
+}
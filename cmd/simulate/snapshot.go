@@ -0,0 +1,66 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	evreqscheme "code.uber.internal/pkg/generated/clientset/versioned/scheme"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/yaml"
+	kubescheme "k8s.io/client-go/kubernetes/scheme"
+)
+
+// loadSnapshot decodes the objects of a snapshot. The file holds YAML documents, each an object or a list of
+// objects as printed by kubectl get -o yaml.
+func loadSnapshot(path string) ([]runtime.Object, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open snapshot: %w", err)
+	}
+	defer f.Close()
+
+	scheme := runtime.NewScheme()
+	utilruntime.Must(kubescheme.AddToScheme(scheme))
+	utilruntime.Must(evreqscheme.AddToScheme(scheme))
+	decoder := serializer.NewCodecFactory(scheme).UniversalDeserializer()
+
+	var objects []runtime.Object
+	reader := yaml.NewYAMLReader(bufio.NewReader(f))
+	for {
+		document, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read snapshot: %w", err)
+		}
+		if len(bytes.TrimSpace(document)) == 0 {
+			continue
+		}
+
+		object, _, err := decoder.Decode(document, nil, nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode snapshot object: %w", err)
+		}
+		list, ok := object.(*corev1.List)
+		if !ok {
+			objects = append(objects, object)
+			continue
+		}
+		for _, item := range list.Items {
+			object, _, err := decoder.Decode(item.Raw, nil, nil)
+			if err != nil {
+				return nil, fmt.Errorf("failed to decode snapshot list item: %w", err)
+			}
+			objects = append(objects, object)
+		}
+	}
+	return objects, nil
+}
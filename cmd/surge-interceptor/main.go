@@ -0,0 +1,67 @@
+// Command surge-interceptor runs the reference "surge before evict" interceptor.
+//
+// It serves the interceptor class in the INTERCEPTOR_CLASS environment variable, or
+// surge.evictionrequest.coordination.uber.com if unset.
+package main
+
+import (
+	"context"
+	"os"
+
+	"code.uber.internal/pkg/config"
+	"code.uber.internal/pkg/generated/clientset/versioned"
+	"code.uber.internal/pkg/interceptors/surge"
+	"code.uber.internal/pkg/interceptorsdk"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+)
+
+func main() {
+	fx.New(
+		fx.Provide(
+			config.NewClients,
+			surge.New,
+			zap.NewDevelopment,
+		),
+		fx.Invoke(run),
+	).Run()
+}
+
+func run(lc fx.Lifecycle, evictionRequestClient versioned.Interface, interceptor *surge.Interceptor, logger *zap.Logger) error {
+	interceptorClass := os.Getenv("INTERCEPTOR_CLASS")
+	if interceptorClass == "" {
+		interceptorClass = surge.DefaultInterceptorClass
+	}
+
+	runner, err := interceptorsdk.New(evictionRequestClient, interceptor, interceptorsdk.Options{
+		InterceptorClass: interceptorClass,
+		Logger:           logger,
+	})
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	lc.Append(fx.Hook{
+		OnStart: func(context.Context) error {
+			go interceptor.RunRestoreLoop(ctx)
+			go func() {
+				defer close(done)
+				if err := runner.Run(ctx); err != nil {
+					logger.Error("Interceptor runner failed", zap.Error(err))
+				}
+			}()
+			return nil
+		},
+		OnStop: func(stopCtx context.Context) error {
+			cancel()
+			select {
+			case <-done:
+			case <-stopCtx.Done():
+			}
+			return nil
+		},
+	})
+	return nil
+}
@@ -0,0 +1,175 @@
+// Package authorization evaluates the EvictionAuthorizationPolicies for the webhook: which identities may claim a
+// requester name and act as an interceptor class, in the status of eviction requests and in heartbeat Leases.
+package authorization
+
+import (
+	"fmt"
+	"slices"
+
+	"code.uber.internal/apis/evictionrequest/v1alpha1"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
+)
+
+// _serviceAccountUsernameFormat is the username the API server gives to service accounts
+const _serviceAccountUsernameFormat = "system:serviceaccount:%s:%s"
+
+// RequesterAllowed reports whether the user may add or remove a requester. Requesters that no policy lists are
+// not restricted.
+func RequesterAllowed(policies []*v1alpha1.EvictionAuthorizationPolicy, requester string, user authenticationv1.UserInfo) bool {
+	restricted := false
+	for _, policy := range policies {
+		for _, authorization := range policy.Spec.Requesters {
+			if authorization.Name != requester {
+				continue
+			}
+			if Matches(authorization.Subjects, user) {
+				return true
+			}
+			restricted = true
+		}
+	}
+	return !restricted
+}
+
+// InterceptorClassAllowed reports whether the user may act as an interceptor class. Interceptor classes that no
+// policy lists are not restricted.
+func InterceptorClassAllowed(policies []*v1alpha1.EvictionAuthorizationPolicy, interceptorClass string, user authenticationv1.UserInfo) bool {
+	restricted := false
+	for _, policy := range policies {
+		for _, authorization := range policy.Spec.InterceptorClasses {
+			if authorization.InterceptorClass != interceptorClass {
+				continue
+			}
+			if Matches(authorization.Subjects, user) {
+				return true
+			}
+			restricted = true
+		}
+	}
+	return !restricted
+}
+
+// Matches reports whether the user is one of the subjects
+func Matches(subjects []v1alpha1.Subject, user authenticationv1.UserInfo) bool {
+	for _, subject := range subjects {
+		switch subject.Kind {
+		case v1alpha1.UserSubject:
+			if user.Username == subject.Name {
+				return true
+			}
+		case v1alpha1.GroupSubject:
+			if slices.Contains(user.Groups, subject.Name) {
+				return true
+			}
+		case v1alpha1.ServiceAccountSubject:
+			if user.Username == fmt.Sprintf(_serviceAccountUsernameFormat, subject.Namespace, subject.Name) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// ValidateRequesters rejects the requesters the user added or removed without being authorized for them
+func ValidateRequesters(policies []*v1alpha1.EvictionAuthorizationPolicy, requesters, oldRequesters []v1alpha1.Requester, user authenticationv1.UserInfo, fldPath *field.Path) field.ErrorList {
+	present := make(map[string]bool, len(requesters))
+	for _, requester := range requesters {
+		present[requester.Name] = true
+	}
+	existing := make(map[string]bool, len(oldRequesters))
+	for _, requester := range oldRequesters {
+		existing[requester.Name] = true
+	}
+
+	var allErrs field.ErrorList
+	for i, requester := range requesters {
+		if !existing[requester.Name] && !RequesterAllowed(policies, requester.Name, user) {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Index(i).Child("name"),
+				fmt.Sprintf("user %q may not add requester %q", user.Username, requester.Name)))
+		}
+	}
+	for _, requester := range oldRequesters {
+		if !present[requester.Name] && !RequesterAllowed(policies, requester.Name, user) {
+			allErrs = append(allErrs, field.Forbidden(fldPath,
+				fmt.Sprintf("user %q may not remove requester %q", user.Username, requester.Name)))
+		}
+	}
+	return allErrs
+}
+
+// ValidateInterceptorStatus rejects changes to the fields that only the controller writes, i.e. the active
+// interceptor class, the interceptor history and the conditions. It also rejects changes to the fields written by
+// the active interceptor, i.e. its heartbeat, expected finish time and completion, unless the user is authorized
+// for the active interceptor class. The writes of the controller are not validated.
+func ValidateInterceptorStatus(policies []*v1alpha1.EvictionAuthorizationPolicy, status, oldStatus *v1alpha1.EvictionRequestStatus, user authenticationv1.UserInfo, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	for _, path := range controllerFieldsChanged(status, oldStatus, fldPath) {
+		allErrs = append(allErrs, field.Forbidden(path,
+			fmt.Sprintf("user %q may not change a field written by the eviction request controller", user.Username)))
+	}
+
+	var changed []*field.Path
+	if !status.HeartbeatTime.Equal(oldStatus.HeartbeatTime) {
+		changed = append(changed, fldPath.Child("heartbeatTime"))
+	}
+	if !status.ExpectedInterceptorFinishTime.Equal(oldStatus.ExpectedInterceptorFinishTime) {
+		changed = append(changed, fldPath.Child("expectedInterceptorFinishTime"))
+	}
+	if status.ActiveInterceptorCompleted != oldStatus.ActiveInterceptorCompleted {
+		changed = append(changed, fldPath.Child("activeInterceptorCompleted"))
+	}
+	if len(changed) == 0 {
+		return allErrs
+	}
+
+	for _, interceptorClass := range activeInterceptorClasses(status, oldStatus) {
+		if InterceptorClassAllowed(policies, interceptorClass, user) {
+			continue
+		}
+		for _, path := range changed {
+			allErrs = append(allErrs, field.Forbidden(path,
+				fmt.Sprintf("user %q may not act as interceptor class %q", user.Username, interceptorClass)))
+		}
+	}
+	return allErrs
+}
+
+// ValidateLeaseHolder rejects a heartbeat Lease held by an interceptor class the user is not authorized for, as
+// the controller takes its renewals as heartbeats of that class
+func ValidateLeaseHolder(policies []*v1alpha1.EvictionAuthorizationPolicy, holderIdentity *string, user authenticationv1.UserInfo, fldPath *field.Path) field.ErrorList {
+	if holderIdentity == nil || InterceptorClassAllowed(policies, *holderIdentity, user) {
+		return nil
+	}
+	return field.ErrorList{field.Forbidden(fldPath,
+		fmt.Sprintf("user %q may not act as interceptor class %q", user.Username, *holderIdentity))}
+}
+
+// controllerFieldsChanged returns the paths of the fields written by the controller that a status update changes
+func controllerFieldsChanged(status, oldStatus *v1alpha1.EvictionRequestStatus, fldPath *field.Path) []*field.Path {
+	var changed []*field.Path
+	if !ptr.Equal(status.ActiveInterceptorClass, oldStatus.ActiveInterceptorClass) {
+		changed = append(changed, fldPath.Child("activeInterceptorClass"))
+	}
+	if !apiequality.Semantic.DeepEqual(status.InterceptorHistory, oldStatus.InterceptorHistory) {
+		changed = append(changed, fldPath.Child("interceptorHistory"))
+	}
+	if !apiequality.Semantic.DeepEqual(status.Conditions, oldStatus.Conditions) {
+		changed = append(changed, fldPath.Child("conditions"))
+	}
+	return changed
+}
+
+// activeInterceptorClasses returns the active interceptor classes before and after a status update
+func activeInterceptorClasses(status, oldStatus *v1alpha1.EvictionRequestStatus) []string {
+	var interceptorClasses []string
+	if oldStatus.ActiveInterceptorClass != nil && *oldStatus.ActiveInterceptorClass != "" {
+		interceptorClasses = append(interceptorClasses, *oldStatus.ActiveInterceptorClass)
+	}
+	if status.ActiveInterceptorClass != nil && *status.ActiveInterceptorClass != "" && !slices.Contains(interceptorClasses, *status.ActiveInterceptorClass) {
+		interceptorClasses = append(interceptorClasses, *status.ActiveInterceptorClass)
+	}
+	return interceptorClasses
+}
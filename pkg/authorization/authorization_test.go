@@ -0,0 +1,139 @@
+package authorization
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"code.uber.internal/apis/evictionrequest/v1alpha1"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
+)
+
+const (
+	_interceptorClass = "surge.example.com"
+	_interceptor      = "system:serviceaccount:surge:interceptor"
+)
+
+// newPolicies returns a policy restricting the interceptor class to its service account
+func newPolicies() []*v1alpha1.EvictionAuthorizationPolicy {
+	return []*v1alpha1.EvictionAuthorizationPolicy{{
+		ObjectMeta: metav1.ObjectMeta{Name: "surge"},
+		Spec: v1alpha1.EvictionAuthorizationPolicySpec{
+			InterceptorClasses: []v1alpha1.InterceptorClassAuthorization{{
+				InterceptorClass: _interceptorClass,
+				Subjects: []v1alpha1.Subject{
+					{Kind: v1alpha1.ServiceAccountSubject, Namespace: "surge", Name: "interceptor"},
+				},
+			}},
+		},
+	}}
+}
+
+func TestValidateInterceptorStatus(t *testing.T) {
+	now := metav1.NewTime(time.Date(2026, 10, 18, 22, 0, 0, 0, time.UTC))
+	oldStatus := v1alpha1.EvictionRequestStatus{
+		ActiveInterceptorClass: ptr.To(_interceptorClass),
+		InterceptorHistory:     []v1alpha1.InterceptorHistoryEntry{{InterceptorClass: _interceptorClass, SelectionTime: now}},
+		Conditions: []metav1.Condition{
+			{Type: "Intercepting", Status: metav1.ConditionTrue, Reason: "Intercepting", LastTransitionTime: now},
+		},
+	}
+
+	for _, tc := range []struct {
+		name           string
+		user           string
+		update         func(status *v1alpha1.EvictionRequestStatus)
+		expectedFields []string
+	}{
+		{
+			name: "heartbeat of the interceptor",
+			user: _interceptor,
+			update: func(status *v1alpha1.EvictionRequestStatus) {
+				status.HeartbeatTime = &now
+				status.ActiveInterceptorCompleted = true
+			},
+		},
+		{
+			name: "heartbeat of another user",
+			user: "mallory",
+			update: func(status *v1alpha1.EvictionRequestStatus) {
+				status.HeartbeatTime = &now
+			},
+			expectedFields: []string{"status.heartbeatTime"},
+		},
+		{
+			name: "active interceptor class",
+			user: _interceptor,
+			update: func(status *v1alpha1.EvictionRequestStatus) {
+				status.ActiveInterceptorClass = ptr.To("other.example.com")
+			},
+			expectedFields: []string{"status.activeInterceptorClass"},
+		},
+		{
+			name: "interceptor history",
+			user: _interceptor,
+			update: func(status *v1alpha1.EvictionRequestStatus) {
+				status.InterceptorHistory[0].Reason = v1alpha1.InterceptorCompleted
+			},
+			expectedFields: []string{"status.interceptorHistory"},
+		},
+		{
+			name: "conditions",
+			user: "mallory",
+			update: func(status *v1alpha1.EvictionRequestStatus) {
+				status.Conditions = nil
+			},
+			expectedFields: []string{"status.conditions"},
+		},
+		{
+			name: "message",
+			user: "mallory",
+			update: func(status *v1alpha1.EvictionRequestStatus) {
+				status.Message = "updated"
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			status := oldStatus.DeepCopy()
+			tc.update(status)
+
+			allErrs := ValidateInterceptorStatus(newPolicies(), status, oldStatus.DeepCopy(),
+				authenticationv1.UserInfo{Username: tc.user}, field.NewPath("status"))
+			var fields []string
+			for _, err := range allErrs {
+				if err.Type != field.ErrorTypeForbidden {
+					t.Errorf("error = %v, expected forbidden", err)
+				}
+				fields = append(fields, err.Field)
+			}
+			if !reflect.DeepEqual(fields, tc.expectedFields) {
+				t.Errorf("ValidateInterceptorStatus() rejected %q, expected %q", fields, tc.expectedFields)
+			}
+		})
+	}
+}
+
+func TestValidateLeaseHolder(t *testing.T) {
+	for _, tc := range []struct {
+		name           string
+		holderIdentity *string
+		user           string
+		expectErr      bool
+	}{
+		{name: "authorized", holderIdentity: ptr.To(_interceptorClass), user: _interceptor},
+		{name: "unauthorized", holderIdentity: ptr.To(_interceptorClass), user: "mallory", expectErr: true},
+		{name: "unrestricted class", holderIdentity: ptr.To("other.example.com"), user: "mallory"},
+		{name: "no holder", user: "mallory"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			allErrs := ValidateLeaseHolder(newPolicies(), tc.holderIdentity, authenticationv1.UserInfo{Username: tc.user},
+				field.NewPath("spec", "holderIdentity"))
+			if (len(allErrs) != 0) != tc.expectErr {
+				t.Errorf("ValidateLeaseHolder() = %v, expected error %v", allErrs, tc.expectErr)
+			}
+		})
+	}
+}
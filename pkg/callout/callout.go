@@ -0,0 +1,252 @@
+package callout
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"sync"
+	"time"
+
+	"code.uber.internal/apis/evictionrequest/v1alpha1"
+	evreqlisters "code.uber.internal/pkg/generated/listers/evictionrequest/v1alpha1"
+	"code.uber.internal/pkg/interceptorclass"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// ConfigEnv is the environment variable holding the path of the callout configuration file
+	ConfigEnv = "CALLOUT_CONFIG"
+
+	_defaultTimeout      = 10 * time.Second
+	_defaultPollInterval = 30 * time.Second
+	_maxResponseBytes    = 1 << 20
+)
+
+// State is the progress reported by a callout endpoint
+type State string
+
+const (
+	// InProgress means the endpoint is still working on the eviction request
+	InProgress State = "InProgress"
+	// Done means the endpoint has finished its work and the next interceptor may proceed
+	Done State = "Done"
+	// Refuse means the endpoint does not handle the eviction request and passes it on
+	Refuse State = "Refuse"
+)
+
+// Endpoint configures an interceptor class that is served by an HTTP or gRPC endpoint
+type Endpoint struct {
+	// InterceptorClass is the class served by the endpoint
+	InterceptorClass string `json:"interceptorClass"`
+	// URL receives a Request for every reconcile of an eviction request assigned to the class: a POST for
+	// http:// and https:// URLs, or a call of the Callout service for grpc:// (plaintext) and grpcs:// (TLS) URLs
+	URL string `json:"url"`
+	// TimeoutSeconds bounds a single call. Defaults to 10.
+	TimeoutSeconds int32 `json:"timeoutSeconds,omitempty"`
+	// PollIntervalSeconds is the delay between calls while the endpoint reports InProgress. Defaults to 30.
+	PollIntervalSeconds int32 `json:"pollIntervalSeconds,omitempty"`
+}
+
+// Config is the content of the callout configuration file
+type Config struct {
+	Endpoints []Endpoint `json:"endpoints"`
+}
+
+// Request is the body sent to a callout endpoint
+type Request struct {
+	EvictionRequest *v1alpha1.EvictionRequest `json:"evictionRequest"`
+}
+
+// Response is the body returned by a callout endpoint
+type Response struct {
+	// State is the progress of the endpoint. This field is required.
+	State State `json:"state"`
+	// ExpectedFinishTime is when the endpoint expects to be done, if it can make an estimate
+	ExpectedFinishTime *time.Time `json:"expectedFinishTime,omitempty"`
+	// Message is a human readable message that is copied into the eviction request status
+	Message string `json:"message,omitempty"`
+}
+
+type Interface interface {
+	// Endpoint returns the endpoint serving the interceptor class, if the class is served by a callout
+	Endpoint(interceptorClass string) (Endpoint, bool)
+	// Call sends the eviction request to the endpoint and returns its response
+	Call(ctx context.Context, endpoint Endpoint, evictionRequest *v1alpha1.EvictionRequest) (*Response, error)
+}
+
+type caller struct {
+	endpoints              map[string]Endpoint
+	interceptorClassLister evreqlisters.InterceptorClassLister
+	httpClient             *http.Client
+	logger                 *zap.Logger
+
+	// grpcConns holds the connections to gRPC endpoints by URL
+	grpcConns map[string]*grpc.ClientConn
+	mu        sync.Mutex
+}
+
+type params struct {
+	fx.In
+
+	InterceptorClassLister evreqlisters.InterceptorClassLister
+	Logger                 *zap.Logger
+}
+
+// New creates a callout caller from the configuration file in CALLOUT_CONFIG.
+// No interceptor class is served by a callout from the file if the variable is not set, but
+// InterceptorClasses can still configure their own callout.
+func New(params params) (Interface, error) {
+	c := &caller{
+		endpoints:              make(map[string]Endpoint),
+		interceptorClassLister: params.InterceptorClassLister,
+		httpClient:             &http.Client{},
+		logger:                 params.Logger,
+		grpcConns:              make(map[string]*grpc.ClientConn),
+	}
+
+	path := os.Getenv(ConfigEnv)
+	if path == "" {
+		return c, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read callout config: %w", err)
+	}
+
+	var config Config
+	if err := yaml.UnmarshalStrict(data, &config); err != nil {
+		return nil, fmt.Errorf("failed to parse callout config: %w", err)
+	}
+
+	for _, endpoint := range config.Endpoints {
+		if endpoint.InterceptorClass == "" || endpoint.URL == "" {
+			return nil, fmt.Errorf("callout endpoint requires interceptorClass and url: %+v", endpoint)
+		}
+		if err := ValidateURL(endpoint.URL); err != nil {
+			return nil, err
+		}
+		c.endpoints[endpoint.InterceptorClass] = endpoint
+	}
+
+	params.Logger.Info("Loaded callout endpoints", zap.Int("count", len(c.endpoints)))
+	return c, nil
+}
+
+// Endpoint returns the endpoint serving the interceptor class. The callout of a registered
+// InterceptorClass takes precedence over the configuration file.
+func (c *caller) Endpoint(interceptorClass string) (Endpoint, bool) {
+	class, err := interceptorclass.Get(c.interceptorClassLister, interceptorClass)
+	if err != nil {
+		c.logger.Warn("Failed to get interceptor class", zap.String("interceptor_class", interceptorClass), zap.Error(err))
+	}
+	if class != nil && class.Spec.Callout != nil {
+		return endpointFromClass(class), true
+	}
+
+	endpoint, ok := c.endpoints[interceptorClass]
+	return endpoint, ok
+}
+
+// endpointFromClass converts the callout of an InterceptorClass into an Endpoint
+func endpointFromClass(class *v1alpha1.InterceptorClass) Endpoint {
+	endpoint := Endpoint{
+		InterceptorClass: class.Name,
+		URL:              class.Spec.Callout.URL,
+	}
+	if class.Spec.Callout.TimeoutSeconds != nil {
+		endpoint.TimeoutSeconds = *class.Spec.Callout.TimeoutSeconds
+	}
+	if class.Spec.Callout.PollIntervalSeconds != nil {
+		endpoint.PollIntervalSeconds = *class.Spec.Callout.PollIntervalSeconds
+	}
+	return endpoint
+}
+
+// Call sends the eviction request to the endpoint, over gRPC for grpc:// and grpcs:// URLs and as an HTTP POST
+// otherwise, and validates its response
+func (c *caller) Call(ctx context.Context, endpoint Endpoint, evictionRequest *v1alpha1.EvictionRequest) (*Response, error) {
+	u, err := url.Parse(endpoint.URL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid callout url %q: %w", endpoint.URL, err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, endpoint.Timeout())
+	defer cancel()
+
+	var response *Response
+	switch u.Scheme {
+	case _grpcScheme, _grpcsScheme:
+		response, err = c.callGRPC(ctx, u, evictionRequest)
+	default:
+		response, err = c.callHTTP(ctx, endpoint, evictionRequest)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	switch response.State {
+	case InProgress, Done, Refuse:
+		return response, nil
+	default:
+		return nil, fmt.Errorf("callout to %s returned unknown state %q", endpoint.URL, response.State)
+	}
+}
+
+// callHTTP posts the eviction request to the endpoint and decodes its response
+func (c *caller) callHTTP(ctx context.Context, endpoint Endpoint, evictionRequest *v1alpha1.EvictionRequest) (*Response, error) {
+	body, err := json.Marshal(Request{EvictionRequest: evictionRequest})
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode callout request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint.URL, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create callout request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("callout to %s failed: %w", endpoint.URL, err)
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, _maxResponseBytes))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read callout response: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("callout to %s returned %s: %s", endpoint.URL, resp.Status, data)
+	}
+
+	var response Response
+	if err := json.Unmarshal(data, &response); err != nil {
+		return nil, fmt.Errorf("failed to decode callout response: %w", err)
+	}
+	return &response, nil
+}
+
+// Timeout returns the timeout of a single call to the endpoint
+func (e Endpoint) Timeout() time.Duration {
+	if e.TimeoutSeconds <= 0 {
+		return _defaultTimeout
+	}
+	return time.Duration(e.TimeoutSeconds) * time.Second
+}
+
+// PollInterval returns the delay between calls while the endpoint is in progress
+func (e Endpoint) PollInterval() time.Duration {
+	if e.PollIntervalSeconds <= 0 {
+		return _defaultPollInterval
+	}
+	return time.Duration(e.PollIntervalSeconds) * time.Second
+}
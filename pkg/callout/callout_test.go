@@ -0,0 +1,144 @@
+package callout
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"code.uber.internal/apis/evictionrequest/v1alpha1"
+	evreqlisters "code.uber.internal/pkg/generated/listers/evictionrequest/v1alpha1"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/encoding"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+)
+
+// serverFunc adapts a function to Server
+type serverFunc func(ctx context.Context, request *Request) (*Response, error)
+
+func (f serverFunc) Intercept(ctx context.Context, request *Request) (*Response, error) {
+	return f(ctx, request)
+}
+
+func newCaller(t *testing.T) Interface {
+	t.Helper()
+
+	c, err := New(params{
+		InterceptorClassLister: evreqlisters.NewInterceptorClassLister(cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})),
+		Logger:                 zap.NewNop(),
+	})
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	return c
+}
+
+// serveHTTP starts an HTTP endpoint answering with the server and returns its URL
+func serveHTTP(t *testing.T, server Server) string {
+	t.Helper()
+
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		request := &Request{}
+		if err := json.NewDecoder(r.Body).Decode(request); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		response, err := server.Intercept(r.Context(), request)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(response)
+	}))
+	t.Cleanup(s.Close)
+	return s.URL
+}
+
+// serveGRPC starts a gRPC endpoint serving the Callout service and returns its URL
+func serveGRPC(t *testing.T, server Server) string {
+	t.Helper()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to listen: %v", err)
+	}
+	s := grpc.NewServer(ServerOption())
+	RegisterServer(s, server)
+	go func() { _ = s.Serve(listener) }()
+	t.Cleanup(s.Stop)
+	return "grpc://" + listener.Addr().String()
+}
+
+func TestCall(t *testing.T) {
+	evictionRequest := &v1alpha1.EvictionRequest{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "pod"}}
+
+	for _, transport := range []struct {
+		name  string
+		serve func(*testing.T, Server) string
+	}{
+		{name: "http", serve: serveHTTP},
+		{name: "grpc", serve: serveGRPC},
+	} {
+		for _, tc := range []struct {
+			name      string
+			response  *Response
+			err       error
+			expectErr bool
+		}{
+			{name: "in progress", response: &Response{State: InProgress, Message: "draining"}},
+			{name: "done", response: &Response{State: Done}},
+			{name: "refuse", response: &Response{State: Refuse}},
+			{name: "unknown state", response: &Response{State: "Unknown"}, expectErr: true},
+			{name: "endpoint error", err: errors.New("unavailable"), expectErr: true},
+		} {
+			t.Run(transport.name+"/"+tc.name, func(t *testing.T) {
+				var received *Request
+				url := transport.serve(t, serverFunc(func(_ context.Context, request *Request) (*Response, error) {
+					received = request
+					return tc.response, tc.err
+				}))
+
+				response, err := newCaller(t).Call(context.Background(), Endpoint{InterceptorClass: "example.com", URL: url}, evictionRequest)
+				if (err != nil) != tc.expectErr {
+					t.Fatalf("Call() error = %v, expected error %v", err, tc.expectErr)
+				}
+				if received == nil || received.EvictionRequest.Name != evictionRequest.Name {
+					t.Errorf("endpoint received %+v, expected eviction request %s", received, evictionRequest.Name)
+				}
+				if tc.expectErr {
+					return
+				}
+				if response.State != tc.response.State || response.Message != tc.response.Message {
+					t.Errorf("Call() = %+v, expected %+v", response, tc.response)
+				}
+			})
+		}
+	}
+}
+
+func TestCodecNotRegistered(t *testing.T) {
+	if codec := encoding.GetCodecV2(CodecName); codec != nil {
+		t.Errorf("codec %q is registered for the process, expected it to be passed to each call", CodecName)
+	}
+}
+
+func TestValidateURL(t *testing.T) {
+	for url, valid := range map[string]bool{
+		"http://example.com/evictions":  true,
+		"https://example.com/evictions": true,
+		"grpc://example.com:8080":       true,
+		"grpcs://example.com:443":       true,
+		"ftp://example.com":             false,
+		"grpc://":                       false,
+		"example.com":                   false,
+	} {
+		if err := ValidateURL(url); (err == nil) != valid {
+			t.Errorf("ValidateURL(%q) error = %v, expected valid %v", url, err, valid)
+		}
+	}
+}
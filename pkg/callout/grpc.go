@@ -0,0 +1,146 @@
+package callout
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"net/url"
+
+	"code.uber.internal/apis/evictionrequest/v1alpha1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/mem"
+)
+
+const (
+	// ServiceName is the gRPC service implemented by endpoints with a grpc:// or grpcs:// URL. Its single
+	// unary method Intercept receives a Request and returns a Response.
+	ServiceName = "evictionrequest.coordination.uber.com.Callout"
+	// CodecName is the content subtype of the calls to gRPC endpoints, which carry the same JSON bodies as
+	// HTTP callouts, so endpoints need no generated code. The codec is passed to each call rather than registered
+	// for the process, so other gRPC users of the binary keep their own codecs.
+	CodecName = "json"
+
+	_interceptMethod = "/" + ServiceName + "/Intercept"
+	_httpScheme      = "http"
+	_httpsScheme     = "https"
+	_grpcScheme      = "grpc"
+	_grpcsScheme     = "grpcs"
+)
+
+// Server is implemented by gRPC callout endpoints
+type Server interface {
+	// Intercept reports the progress of the endpoint on the eviction request of the request
+	Intercept(ctx context.Context, request *Request) (*Response, error)
+}
+
+// RegisterServer registers the Callout service of a gRPC callout endpoint. The server must be created with
+// ServerOption to decode the JSON messages of the service.
+func RegisterServer(registrar grpc.ServiceRegistrar, server Server) {
+	registrar.RegisterService(&_serviceDesc, server)
+}
+
+// ServerOption makes a gRPC server encode its messages as JSON, the encoding used by the Callout service. It
+// applies to every service of the server, so callout endpoints should be served by a dedicated server.
+func ServerOption() grpc.ServerOption {
+	return grpc.ForceServerCodecV2(jsonCodec{})
+}
+
+var _serviceDesc = grpc.ServiceDesc{
+	ServiceName: ServiceName,
+	HandlerType: (*Server)(nil),
+	Methods: []grpc.MethodDesc{{
+		MethodName: "Intercept",
+		Handler:    interceptHandler,
+	}},
+}
+
+func interceptHandler(server any, ctx context.Context, decode func(any) error, interceptor grpc.UnaryServerInterceptor) (any, error) {
+	request := &Request{}
+	if err := decode(request); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return server.(Server).Intercept(ctx, request)
+	}
+	info := &grpc.UnaryServerInfo{Server: server, FullMethod: _interceptMethod}
+	return interceptor(ctx, request, info, func(ctx context.Context, request any) (any, error) {
+		return server.(Server).Intercept(ctx, request.(*Request))
+	})
+}
+
+// ValidateURL checks that the URL of an endpoint can be called
+func ValidateURL(rawURL string) error {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return fmt.Errorf("invalid callout url %q: %w", rawURL, err)
+	}
+	switch u.Scheme {
+	case _httpScheme, _httpsScheme, _grpcScheme, _grpcsScheme:
+	default:
+		return fmt.Errorf("invalid callout url %q: scheme must be http, https, grpc or grpcs", rawURL)
+	}
+	if u.Host == "" {
+		return fmt.Errorf("invalid callout url %q: host is required", rawURL)
+	}
+	return nil
+}
+
+// callGRPC calls the Callout service of the endpoint
+func (c *caller) callGRPC(ctx context.Context, u *url.URL, evictionRequest *v1alpha1.EvictionRequest) (*Response, error) {
+	conn, err := c.grpcConn(u)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &Response{}
+	err = conn.Invoke(ctx, _interceptMethod, &Request{EvictionRequest: evictionRequest}, response, grpc.ForceCodecV2(jsonCodec{}))
+	if err != nil {
+		return nil, fmt.Errorf("callout to %s failed: %w", u, err)
+	}
+	return response, nil
+}
+
+// grpcConn returns the connection to a gRPC endpoint. Connections are shared by all calls to the endpoint and
+// reconnect on their own.
+func (c *caller) grpcConn(u *url.URL) (*grpc.ClientConn, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	key := u.String()
+	if conn, ok := c.grpcConns[key]; ok {
+		return conn, nil
+	}
+
+	transportCredentials := insecure.NewCredentials()
+	if u.Scheme == _grpcsScheme {
+		transportCredentials = credentials.NewTLS(&tls.Config{MinVersion: tls.VersionTLS12})
+	}
+	conn, err := grpc.NewClient(u.Host, grpc.WithTransportCredentials(transportCredentials))
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to callout %s: %w", u, err)
+	}
+	c.grpcConns[key] = conn
+	return conn, nil
+}
+
+// jsonCodec encodes the messages of the Callout service as JSON
+type jsonCodec struct{}
+
+func (jsonCodec) Marshal(v any) (mem.BufferSlice, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	return mem.BufferSlice{mem.SliceBuffer(data)}, nil
+}
+
+func (jsonCodec) Unmarshal(data mem.BufferSlice, v any) error {
+	return json.Unmarshal(data.Materialize(), v)
+}
+
+func (jsonCodec) Name() string {
+	return CodecName
+}
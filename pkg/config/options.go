@@ -0,0 +1,109 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+)
+
+const (
+	// FinishTimeGraceMultiplierEnv is the environment variable holding the grace multiplier applied to the
+	// expected finish time of interceptors. An interceptor times out once the time since its selection
+	// exceeds its estimate multiplied by this value. Disabled if not set or 0.
+	FinishTimeGraceMultiplierEnv = "FINISH_TIME_GRACE_MULTIPLIER"
+	// MaxClockSkewEnv is the environment variable holding the maximum time a heartbeat may be in the future,
+	// as a Go duration. Defaults to 1m.
+	MaxClockSkewEnv = "MAX_CLOCK_SKEW"
+	// GCIntervalEnv is the environment variable holding the interval at which expired eviction requests are
+	// deleted, as a Go duration. Defaults to 1m.
+	GCIntervalEnv = "GC_INTERVAL"
+	// ShadowEnv is the environment variable enabling the shadow mode: the controller computes every decision
+	// but sends its writes as dry runs and compares its decisions with the ones of the production controller.
+	// Disabled if not set.
+	ShadowEnv = "SHADOW_MODE"
+	// MigrateStorageVersionEnv is the environment variable making the leader rewrite every eviction request in
+	// the storage version of the CRD when it starts leading. Disabled if not set.
+	MigrateStorageVersionEnv = "MIGRATE_STORAGE_VERSION"
+	// LeaseHeartbeatsEnv is the environment variable making the controller watch the heartbeat Leases of
+	// interceptors and count their renewals as heartbeats. Disabled if not set.
+	LeaseHeartbeatsEnv = "LEASE_HEARTBEATS"
+
+	_defaultMaxClockSkew = time.Minute
+	_defaultGCInterval   = time.Minute
+)
+
+// Options holds the tunables of the eviction request controller
+type Options struct {
+	// FinishTimeGraceMultiplier is applied to the expected finish time of interceptors to derive an
+	// additional deadline. 0 disables the deadline.
+	FinishTimeGraceMultiplier float64
+	// MaxClockSkew is the maximum time a heartbeat may be in the future
+	MaxClockSkew time.Duration
+	// GCInterval is the interval at which expired eviction requests are deleted
+	GCInterval time.Duration
+	// Shadow makes the controller send its writes as dry runs
+	Shadow bool
+	// MigrateStorageVersion makes the leader rewrite every eviction request in the storage version
+	MigrateStorageVersion bool
+	// LeaseHeartbeats makes the controller count the renewals of heartbeat Leases as heartbeats
+	LeaseHeartbeats bool
+}
+
+// NewOptions reads the controller options from the environment
+func NewOptions() (Options, error) {
+	options := Options{
+		MaxClockSkew: _defaultMaxClockSkew,
+		GCInterval:   _defaultGCInterval,
+	}
+
+	if value := os.Getenv(FinishTimeGraceMultiplierEnv); value != "" {
+		multiplier, err := strconv.ParseFloat(value, 64)
+		if err != nil || multiplier < 0 || (multiplier > 0 && multiplier < 1) {
+			return Options{}, fmt.Errorf("invalid %s %q: must be 0 or at least 1", FinishTimeGraceMultiplierEnv, value)
+		}
+		options.FinishTimeGraceMultiplier = multiplier
+	}
+
+	if value := os.Getenv(MaxClockSkewEnv); value != "" {
+		skew, err := time.ParseDuration(value)
+		if err != nil || skew < 0 {
+			return Options{}, fmt.Errorf("invalid %s %q: must be a non-negative duration", MaxClockSkewEnv, value)
+		}
+		options.MaxClockSkew = skew
+	}
+
+	if value := os.Getenv(GCIntervalEnv); value != "" {
+		interval, err := time.ParseDuration(value)
+		if err != nil || interval <= 0 {
+			return Options{}, fmt.Errorf("invalid %s %q: must be a positive duration", GCIntervalEnv, value)
+		}
+		options.GCInterval = interval
+	}
+
+	if value := os.Getenv(ShadowEnv); value != "" {
+		shadow, err := strconv.ParseBool(value)
+		if err != nil {
+			return Options{}, fmt.Errorf("invalid %s %q: must be a boolean", ShadowEnv, value)
+		}
+		options.Shadow = shadow
+	}
+
+	if value := os.Getenv(MigrateStorageVersionEnv); value != "" {
+		migrate, err := strconv.ParseBool(value)
+		if err != nil {
+			return Options{}, fmt.Errorf("invalid %s %q: must be a boolean", MigrateStorageVersionEnv, value)
+		}
+		options.MigrateStorageVersion = migrate
+	}
+
+	if value := os.Getenv(LeaseHeartbeatsEnv); value != "" {
+		leaseHeartbeats, err := strconv.ParseBool(value)
+		if err != nil {
+			return Options{}, fmt.Errorf("invalid %s %q: must be a boolean", LeaseHeartbeatsEnv, value)
+		}
+		options.LeaseHeartbeats = leaseHeartbeats
+	}
+
+	return options, nil
+}
@@ -12,6 +12,14 @@ const (
 	ConditionTypeIntercepting = "Intercepting"
 	// ConditionTypeEvicted is the condition type for the EvictionRequest resource
 	ConditionTypeEvicted = "Evicted"
+	// ConditionTypeInterceptorOverdue is true while the active interceptor is past its expected finish time
+	ConditionTypeInterceptorOverdue = "InterceptorOverdue"
+	// ConditionTypeDuplicate is true while an older eviction request targets the same pod
+	ConditionTypeDuplicate = "Duplicate"
+	// ConditionTypeWaitingForEvictionBudget is true while the eviction waits for a rate limit or concurrency budget
+	ConditionTypeWaitingForEvictionBudget = "WaitingForEvictionBudget"
+	// ConditionTypeWaitingForEvictionWindow is true while an EvictionSchedule holds back the eviction
+	ConditionTypeWaitingForEvictionWindow = "WaitingForEvictionWindow"
 
 	// ReasonPodNotFound is the reason for the EvictionRequest resource
 	ReasonPodNotFound = "PodNotFound"
@@ -23,4 +31,29 @@ const (
 	ReasonEvictionSucceeded = "EvictionSucceeded"
 	// ReasonEvictionFailed is the reason for the EvictionRequest resource
 	ReasonEvictionFailed = "EvictionFailed"
+	// ReasonExpectedFinishTimeExceeded is the reason for an overdue interceptor
+	ReasonExpectedFinishTimeExceeded = "ExpectedFinishTimeExceeded"
+	// ReasonWithinExpectedFinishTime is the reason for an interceptor that is no longer overdue
+	ReasonWithinExpectedFinishTime = "WithinExpectedFinishTime"
+	// ReasonEvictionBudgetAvailable is the reason for an eviction that no longer waits for its budget
+	ReasonEvictionBudgetAvailable = "EvictionBudgetAvailable"
+	// ReasonEvictionWindowOpen is the reason for an eviction that is no longer held back by an EvictionSchedule
+	ReasonEvictionWindowOpen = "EvictionWindowOpen"
+	// ReasonDirectEvictionFallbackNotAllowed is the reason for a pod that is not evicted because no interceptor
+	// completed and the eviction policy of its namespace does not allow evicting it directly
+	ReasonDirectEvictionFallbackNotAllowed = "DirectEvictionFallbackNotAllowed"
+	// ReasonForbidNotAllowed is the event reason for a Forbid cancellation policy reset by the eviction policy
+	ReasonForbidNotAllowed = "ForbidNotAllowed"
+	// ReasonDuplicateEvictionRequest is the reason for an eviction request that is not processed because an older
+	// eviction request targets the same pod
+	ReasonDuplicateEvictionRequest = "DuplicateEvictionRequest"
+	// ReasonPrimaryEvictionRequest is the reason for a former duplicate that became the processed eviction request
+	ReasonPrimaryEvictionRequest = "PrimaryEvictionRequest"
+	// ReasonTargetReplaced is the reason for an eviction request whose target pod was replaced by a pod with the
+	// same name
+	ReasonTargetReplaced = "TargetReplaced"
+	// ReasonRetargeted is the event reason for an eviction request recreated for the replacement of its pod
+	ReasonRetargeted = "Retargeted"
+	// ReasonFutureHeartbeat is the event reason for a heartbeat that is too far in the future
+	ReasonFutureHeartbeat = "FutureHeartbeat"
 )
@@ -6,14 +6,22 @@ import (
 	"time"
 
 	"code.uber.internal/apis/evictionrequest/v1alpha1"
+	"code.uber.internal/pkg/config"
+	"code.uber.internal/pkg/duplicate"
+	"code.uber.internal/pkg/gc"
 	"code.uber.internal/pkg/generated/clientset/versioned"
 	evreqinformer "code.uber.internal/pkg/generated/informers/externalversions"
+	"code.uber.internal/pkg/heartbeat"
+	"code.uber.internal/pkg/migration"
 	"code.uber.internal/pkg/reconciler"
+	"code.uber.internal/pkg/shadow"
 	"code.uber.internal/pkg/worker"
 	"github.com/google/uuid"
 	"go.uber.org/fx"
 	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/client-go/informers"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/tools/cache"
@@ -27,6 +35,9 @@ const (
 	_leaseDuration      = 15 * time.Second
 	_leaseRenewDeadline = 10 * time.Second
 	_leaseRetryPeriod   = 2 * time.Second
+	// _shadowLeaseSuffix is appended to the lease name in shadow mode, so the shadow leads independently of
+	// the production controller
+	_shadowLeaseSuffix = "-shadow"
 )
 
 type Interface interface {
@@ -43,6 +54,11 @@ type controller struct {
 
 	reconciler reconciler.Interface
 	worker     worker.Interface
+	gc         gc.Interface
+	shadow     shadow.Interface
+	migration  migration.Interface
+	heartbeat  heartbeat.Interface
+	options    config.Options
 
 	evictionRequestInformerFactory evreqinformer.SharedInformerFactory
 	kubeInformerFactory            informers.SharedInformerFactory
@@ -57,6 +73,11 @@ type params struct {
 
 	Reconciler reconciler.Interface
 	Worker     worker.Interface
+	GC         gc.Interface
+	Shadow     shadow.Interface
+	Migration  migration.Interface
+	Heartbeat  heartbeat.Interface
+	Options    config.Options
 
 	KubeClient            kubernetes.Interface
 	EvictionRequestClient versioned.Interface
@@ -76,6 +97,11 @@ func New(params params) Interface {
 		reconciler:                     params.Reconciler,
 		logger:                         params.Logger,
 		worker:                         params.Worker,
+		gc:                             params.GC,
+		shadow:                         params.Shadow,
+		migration:                      params.Migration,
+		heartbeat:                      params.Heartbeat,
+		options:                        params.Options,
 		evictionRequestInformerFactory: params.EvictionRequestInformerFactory,
 		kubeInformerFactory:            params.KubeInformerFactory,
 	}
@@ -106,9 +132,15 @@ func (c *controller) createLeaderElectionConfig() leaderelection.LeaderElectionC
 
 // createResourceLock creates the resource lock for leader election
 func (c *controller) createResourceLock(id string) resourcelock.Interface {
+	name := _leaseName
+	if c.options.Shadow {
+		name += _shadowLeaseSuffix
+		c.logger.Info("Running in shadow mode, writes are sent as dry runs", zap.String("lease", name))
+	}
+
 	return &resourcelock.LeaseLock{
 		LeaseMeta: metav1.ObjectMeta{
-			Name:      _leaseName,
+			Name:      name,
 			Namespace: _leaseNamespace,
 		},
 		Client: c.kubeClient.CoordinationV1(),
@@ -145,6 +177,12 @@ func (c *controller) onStartedLeading(ctx context.Context) {
 
 	// Start worker
 	go c.worker.Start(ctx)
+
+	// Start garbage collection of expired eviction requests
+	go c.gc.Run(ctx)
+
+	// Rewrite eviction requests in the storage version if requested
+	go c.migration.Run(ctx)
 }
 
 // onStoppedLeading handles the logic when the controller stops being the leader
@@ -165,16 +203,54 @@ func (c *controller) onStoppedLeading() {
 	}
 }
 
-// setupEventHandlers sets up the event handlers for EvictionRequest informer
+// setupEventHandlers sets up the event handlers for the EvictionRequest and Pod informers
 func (c *controller) setupEventHandlers() {
 	evictionRequestInformer := c.evictionRequestInformerFactory.Evictionrequest().V1alpha1().EvictionRequests().Informer()
 
 	_, _ = evictionRequestInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
 		AddFunc:    c.handleEvictionRequestAdd,
 		UpdateFunc: c.handleEvictionRequestUpdate,
+		DeleteFunc: c.handleEvictionRequestDelete,
+	})
+
+	podInformer := c.kubeInformerFactory.Core().V1().Pods().Informer()
+
+	_, _ = podInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		DeleteFunc: c.handlePodDelete,
 	})
 }
 
+// handlePodDelete enqueues the eviction requests targeting a deleted pod, so they complete without waiting
+// for the next resync
+func (c *controller) handlePodDelete(obj interface{}) {
+	if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+	pod, ok := obj.(*corev1.Pod)
+	if !ok {
+		c.logger.Warn("Received non-Pod object in delete handler", zap.Any("obj", obj))
+		return
+	}
+
+	evictionRequests, err := c.evictionRequestInformerFactory.Evictionrequest().V1alpha1().EvictionRequests().Lister().
+		EvictionRequests(pod.Namespace).List(labels.Everything())
+	if err != nil {
+		c.logger.Error("Failed to list eviction requests", zap.Error(err))
+		return
+	}
+	for _, evictionRequest := range evictionRequests {
+		podRef := evictionRequest.Spec.Target.PodRef
+		if podRef == nil || podRef.Name != pod.Name {
+			continue
+		}
+		c.logger.Debug("Target pod deleted, enqueuing eviction request",
+			zap.String("name", evictionRequest.Name),
+			zap.String("namespace", evictionRequest.Namespace),
+			zap.String("target_pod_name", pod.Name))
+		c.worker.Enqueue(evictionRequest)
+	}
+}
+
 // handleEvictionRequestAdd handles EvictionRequest add events
 func (c *controller) handleEvictionRequestAdd(obj interface{}) {
 	evictionRequest, ok := obj.(*v1alpha1.EvictionRequest)
@@ -208,7 +284,48 @@ func (c *controller) handleEvictionRequestUpdate(oldObj, newObj interface{}) {
 		zap.Any("old_eviction_request_status", oldEvictionRequest.Status),
 		zap.Any("new_eviction_request_spec", newEvictionRequest.Spec),
 		zap.Any("new_eviction_request_status", newEvictionRequest.Status))
+	c.shadow.Observe(oldEvictionRequest, newEvictionRequest)
 	c.worker.Enqueue(newEvictionRequest)
+
+	if duplicate.IsActive(oldEvictionRequest) && !duplicate.IsActive(newEvictionRequest) {
+		c.enqueueDuplicates(oldEvictionRequest)
+	}
+}
+
+// handleEvictionRequestDelete handles EvictionRequest delete events
+func (c *controller) handleEvictionRequestDelete(obj interface{}) {
+	if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+	evictionRequest, ok := obj.(*v1alpha1.EvictionRequest)
+	if !ok {
+		c.logger.Warn("Received non-EvictionRequest object in delete handler", zap.Any("obj", obj))
+		return
+	}
+
+	c.logger.Info("EvictionRequest deleted", zap.String("name", evictionRequest.Name), zap.String("namespace", evictionRequest.Namespace))
+	c.shadow.Forget(evictionRequest)
+	c.enqueueDuplicates(evictionRequest)
+}
+
+// enqueueDuplicates enqueues the other eviction requests targeting the pod of an eviction request that is no
+// longer active, so the next one becomes the primary
+func (c *controller) enqueueDuplicates(evictionRequest *v1alpha1.EvictionRequest) {
+	if evictionRequest.Spec.Target.PodRef == nil {
+		return
+	}
+
+	evictionRequests, err := c.evictionRequestInformerFactory.Evictionrequest().V1alpha1().EvictionRequests().Lister().
+		EvictionRequests(evictionRequest.Namespace).List(labels.Everything())
+	if err != nil {
+		c.logger.Error("Failed to list eviction requests", zap.Error(err))
+		return
+	}
+	for _, other := range duplicate.Active(evictionRequests, evictionRequest.Spec.Target.PodRef.UID) {
+		if other.UID != evictionRequest.UID {
+			c.worker.Enqueue(other)
+		}
+	}
 }
 
 // startInformers starts all informers and waits for cache sync
@@ -233,6 +350,12 @@ func (c *controller) startInformers() bool {
 		allSynced = false
 	}
 
+	// Start the heartbeat Lease informer, if Lease heartbeats are enabled
+	if !c.verifyCacheSync("heartbeat", c.heartbeat.Start(c.stopCh)) {
+		c.logger.Error("Heartbeat lease informer failed to sync cache - lease heartbeats may be missed")
+		allSynced = false
+	}
+
 	if allSynced {
 		c.logger.Info("All informers started and synced")
 		return true
@@ -0,0 +1,86 @@
+package controller
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"code.uber.internal/apis/evictionrequest/v1alpha1"
+	evreqfake "code.uber.internal/pkg/generated/clientset/versioned/fake"
+	evreqinformer "code.uber.internal/pkg/generated/informers/externalversions"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+)
+
+// fakeWorker records the eviction requests it is asked to enqueue
+type fakeWorker struct {
+	mu       sync.Mutex
+	enqueued []string
+}
+
+func (w *fakeWorker) Enqueue(obj interface{}) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.enqueued = append(w.enqueued, obj.(*v1alpha1.EvictionRequest).Name)
+}
+
+func (w *fakeWorker) Start(context.Context) {}
+
+func (w *fakeWorker) Process(context.Context) bool { return false }
+
+func (w *fakeWorker) GetWorkqueue() workqueue.RateLimitingInterface { return nil }
+
+func newTargetingEvictionRequest(namespace, name, podName string) *v1alpha1.EvictionRequest {
+	return &v1alpha1.EvictionRequest{
+		ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name, UID: types.UID(name + "-uid")},
+		Spec: v1alpha1.EvictionRequestSpec{
+			Target: v1alpha1.EvictionTarget{PodRef: &v1alpha1.LocalPodReference{Name: podName, UID: podName + "-uid"}},
+		},
+	}
+}
+
+func TestHandlePodDeleteEnqueuesTargetingEvictionRequests(t *testing.T) {
+	client := evreqfake.NewSimpleClientset(
+		newTargetingEvictionRequest("default", "first", "pod"),
+		newTargetingEvictionRequest("default", "second", "pod"),
+		newTargetingEvictionRequest("default", "other", "other-pod"),
+		newTargetingEvictionRequest("other", "same-name", "pod"),
+	)
+	informerFactory := evreqinformer.NewSharedInformerFactory(client, 0)
+	informerFactory.Evictionrequest().V1alpha1().EvictionRequests().Informer()
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	informerFactory.Start(stopCh)
+	informerFactory.WaitForCacheSync(stopCh)
+
+	w := &fakeWorker{}
+	c := &controller{logger: zap.NewNop(), worker: w, evictionRequestInformerFactory: informerFactory}
+	pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "pod", UID: "pod-uid"}}
+
+	for _, tc := range []struct {
+		name string
+		obj  interface{}
+	}{
+		{name: "pod", obj: pod},
+		{name: "tombstone", obj: cache.DeletedFinalStateUnknown{Key: "default/pod", Obj: pod}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			w.enqueued = nil
+			c.handlePodDelete(tc.obj)
+
+			expected := map[string]bool{"first": true, "second": true}
+			if len(w.enqueued) != len(expected) {
+				t.Fatalf("enqueued %v, expected %v", w.enqueued, expected)
+			}
+			for _, name := range w.enqueued {
+				if !expected[name] {
+					t.Errorf("enqueued %s, expected only the eviction requests targeting the pod", name)
+				}
+			}
+		})
+	}
+}
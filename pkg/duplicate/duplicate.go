@@ -0,0 +1,64 @@
+// Package duplicate finds the eviction requests targeting the same pod. Only one of them, the primary,
+// is processed; requesters are expected to join it through .spec.requesters instead of creating another.
+package duplicate
+
+import (
+	"sort"
+
+	"code.uber.internal/apis/evictionrequest/v1alpha1"
+	"k8s.io/apimachinery/pkg/api/meta"
+)
+
+// IsActive reports whether the eviction request still asks for the eviction of its pod: it is not being
+// deleted, has a requester and is not complete
+func IsActive(evictionRequest *v1alpha1.EvictionRequest) bool {
+	return evictionRequest.DeletionTimestamp == nil &&
+		len(evictionRequest.Spec.Requesters) > 0 &&
+		evictionRequest.Spec.Target.PodRef != nil &&
+		!meta.IsStatusConditionTrue(evictionRequest.Status.Conditions, string(v1alpha1.EvictionRequestComplete))
+}
+
+// Active returns the active eviction requests targeting the pod with the given UID
+func Active(evictionRequests []*v1alpha1.EvictionRequest, podUID string) []*v1alpha1.EvictionRequest {
+	var active []*v1alpha1.EvictionRequest
+	for _, evictionRequest := range evictionRequests {
+		if IsActive(evictionRequest) && evictionRequest.Spec.Target.PodRef.UID == podUID {
+			active = append(active, evictionRequest)
+		}
+	}
+	return active
+}
+
+// Primary returns the eviction request that is processed among the ones targeting the same pod: the oldest,
+// and the first by name if they were created at the same time. It returns nil for an empty list.
+func Primary(evictionRequests []*v1alpha1.EvictionRequest) *v1alpha1.EvictionRequest {
+	if len(evictionRequests) == 0 {
+		return nil
+	}
+	sorted := append([]*v1alpha1.EvictionRequest(nil), evictionRequests...)
+	sort.Slice(sorted, func(i, j int) bool {
+		if !sorted[i].CreationTimestamp.Equal(&sorted[j].CreationTimestamp) {
+			return sorted[i].CreationTimestamp.Before(&sorted[j].CreationTimestamp)
+		}
+		return sorted[i].Name < sorted[j].Name
+	})
+	return sorted[0]
+}
+
+// MergeRequesters adds the requesters that are missing from the eviction request and returns whether any was added
+func MergeRequesters(evictionRequest *v1alpha1.EvictionRequest, requesters []v1alpha1.Requester) bool {
+	existing := make(map[string]bool, len(evictionRequest.Spec.Requesters))
+	for _, requester := range evictionRequest.Spec.Requesters {
+		existing[requester.Name] = true
+	}
+
+	added := false
+	for _, requester := range requesters {
+		if !existing[requester.Name] {
+			evictionRequest.Spec.Requesters = append(evictionRequest.Spec.Requesters, requester)
+			existing[requester.Name] = true
+			added = true
+		}
+	}
+	return added
+}
@@ -0,0 +1,50 @@
+// Package events provides the recorder used to emit Kubernetes events about EvictionRequests.
+package events
+
+import (
+	"context"
+
+	evreqscheme "code.uber.internal/pkg/generated/clientset/versioned/scheme"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/client-go/kubernetes"
+	kubescheme "k8s.io/client-go/kubernetes/scheme"
+	typedcorev1 "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/client-go/tools/record"
+)
+
+// _component is the source component of the recorded events
+const _component = "eviction-request-controller"
+
+type params struct {
+	fx.In
+
+	Lifecycle  fx.Lifecycle
+	KubeClient kubernetes.Interface
+	Logger     *zap.Logger
+}
+
+// New creates an event recorder that writes events through the Kubernetes API. The broadcaster is shut
+// down with the fx application.
+func New(params params) record.EventRecorder {
+	scheme := runtime.NewScheme()
+	utilruntime.Must(kubescheme.AddToScheme(scheme))
+	utilruntime.Must(evreqscheme.AddToScheme(scheme))
+
+	broadcaster := record.NewBroadcaster()
+	broadcaster.StartStructuredLogging(0)
+	broadcaster.StartRecordingToSink(&typedcorev1.EventSinkImpl{Interface: params.KubeClient.CoreV1().Events("")})
+
+	params.Lifecycle.Append(fx.Hook{
+		OnStop: func(ctx context.Context) error {
+			params.Logger.Info("Shutting down event broadcaster")
+			broadcaster.Shutdown()
+			return nil
+		},
+	})
+
+	return broadcaster.NewRecorder(scheme, corev1.EventSource{Component: _component})
+}
@@ -0,0 +1,182 @@
+// Package evictionpolicy resolves the EvictionPolicies of a namespace for the controller and the webhook.
+package evictionpolicy
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"code.uber.internal/apis/evictionrequest/v1alpha1"
+	evreqlisters "code.uber.internal/pkg/generated/listers/evictionrequest/v1alpha1"
+	"go.uber.org/zap"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	corev1listers "k8s.io/client-go/listers/core/v1"
+)
+
+// APIDefaultHeartbeatDeadlineSeconds is the CRD default of .spec.heartbeatDeadlineSeconds. The API server
+// applies it before admission, so it is the value of eviction requests created without a heartbeat deadline.
+const APIDefaultHeartbeatDeadlineSeconds = 1800
+
+// Resolve returns the effective policy of a namespace, merged from the EvictionPolicies selecting it.
+// The result is empty if no policy selects the namespace. Policies with an invalid namespace selector are
+// skipped so that they do not affect other namespaces, the webhook rejects them on admission.
+func Resolve(policyLister evreqlisters.EvictionPolicyLister, namespaceLister corev1listers.NamespaceLister, namespace string, logger *zap.Logger) (*v1alpha1.EvictionPolicySpec, error) {
+	policies, err := policyLister.List(labels.Everything())
+	if err != nil {
+		return nil, fmt.Errorf("failed to list eviction policies: %w", err)
+	}
+	if len(policies) == 0 {
+		return &v1alpha1.EvictionPolicySpec{}, nil
+	}
+
+	ns, err := namespaceLister.Get(namespace)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get namespace: %w", err)
+	}
+
+	var applying []*v1alpha1.EvictionPolicy
+	for _, policy := range policies {
+		selector := labels.Everything()
+		if policy.Spec.NamespaceSelector != nil {
+			selector, err = metav1.LabelSelectorAsSelector(policy.Spec.NamespaceSelector)
+			if err != nil {
+				logger.Warn("Skipping eviction policy with invalid namespace selector", zap.String("eviction_policy", policy.Name), zap.Error(err))
+				continue
+			}
+		}
+		if selector.Matches(labels.Set(ns.Labels)) {
+			applying = append(applying, policy)
+		}
+	}
+	return Merge(applying), nil
+}
+
+// Merge merges policies into the effective policy. Each field is taken from the policy with the highest
+// precedence that sets it, and from the first by name for equal precedences.
+func Merge(policies []*v1alpha1.EvictionPolicy) *v1alpha1.EvictionPolicySpec {
+	sorted := append([]*v1alpha1.EvictionPolicy(nil), policies...)
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i].Spec.Precedence != sorted[j].Spec.Precedence {
+			return sorted[i].Spec.Precedence > sorted[j].Spec.Precedence
+		}
+		return sorted[i].Name < sorted[j].Name
+	})
+
+	effective := &v1alpha1.EvictionPolicySpec{}
+	for _, policy := range sorted {
+		spec := &policy.Spec
+		if effective.DefaultInterceptors == nil && len(spec.DefaultInterceptors) > 0 {
+			effective.DefaultInterceptors = spec.DefaultInterceptors
+		}
+		if effective.DefaultHeartbeatDeadlineSeconds == nil {
+			effective.DefaultHeartbeatDeadlineSeconds = spec.DefaultHeartbeatDeadlineSeconds
+		}
+		if effective.MaxHeartbeatDeadlineSeconds == nil {
+			effective.MaxHeartbeatDeadlineSeconds = spec.MaxHeartbeatDeadlineSeconds
+		}
+		if effective.AllowForbid == nil {
+			effective.AllowForbid = spec.AllowForbid
+		}
+		if effective.AllowedRequesters == nil && len(spec.AllowedRequesters) > 0 {
+			effective.AllowedRequesters = spec.AllowedRequesters
+		}
+		if effective.AllowDirectEvictionFallback == nil {
+			effective.AllowDirectEvictionFallback = spec.AllowDirectEvictionFallback
+		}
+		if effective.TTLSecondsAfterCompletion == nil {
+			effective.TTLSecondsAfterCompletion = spec.TTLSecondsAfterCompletion
+		}
+	}
+	return effective.DeepCopy()
+}
+
+// HeartbeatDeadline caps the heartbeat deadline of an eviction request with the maximum of the policy
+func HeartbeatDeadline(policy *v1alpha1.EvictionPolicySpec, deadline time.Duration) time.Duration {
+	if policy == nil || policy.MaxHeartbeatDeadlineSeconds == nil {
+		return deadline
+	}
+	if max := time.Duration(*policy.MaxHeartbeatDeadlineSeconds) * time.Second; max < deadline {
+		return max
+	}
+	return deadline
+}
+
+// ForbidAllowed reports whether interceptors may forbid the cancellation of eviction requests
+func ForbidAllowed(policy *v1alpha1.EvictionPolicySpec) bool {
+	return policy == nil || policy.AllowForbid == nil || *policy.AllowForbid
+}
+
+// DirectEvictionFallbackAllowed reports whether pods may be evicted directly when no interceptor completed
+func DirectEvictionFallbackAllowed(policy *v1alpha1.EvictionPolicySpec) bool {
+	return policy == nil || policy.AllowDirectEvictionFallback == nil || *policy.AllowDirectEvictionFallback
+}
+
+// RequesterAllowed reports whether the requester may be added to eviction requests
+func RequesterAllowed(policy *v1alpha1.EvictionPolicySpec, name string) bool {
+	if policy == nil || len(policy.AllowedRequesters) == 0 {
+		return true
+	}
+	for _, allowed := range policy.AllowedRequesters {
+		if allowed == name {
+			return true
+		}
+	}
+	return false
+}
+
+// TTLAfterCompletion returns the time after which a complete eviction request is deleted: its own TTL, or the
+// TTL of the policy. It returns false if the eviction request is kept.
+func TTLAfterCompletion(evictionRequest *v1alpha1.EvictionRequest, policy *v1alpha1.EvictionPolicySpec) (time.Duration, bool) {
+	ttl := evictionRequest.Spec.TTLSecondsAfterCompletion
+	if ttl == nil && policy != nil {
+		ttl = policy.TTLSecondsAfterCompletion
+	}
+	if ttl == nil {
+		return 0, false
+	}
+	return time.Duration(*ttl) * time.Second, true
+}
+
+// ValidateRequesters rejects requesters that are not allowed by the policy and were not already present
+func ValidateRequesters(requesters, oldRequesters []v1alpha1.Requester, policy *v1alpha1.EvictionPolicySpec, fldPath *field.Path) field.ErrorList {
+	existing := make(map[string]bool, len(oldRequesters))
+	for _, requester := range oldRequesters {
+		existing[requester.Name] = true
+	}
+
+	var allErrs field.ErrorList
+	for i, requester := range requesters {
+		if !existing[requester.Name] && !RequesterAllowed(policy, requester.Name) {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Index(i).Child("name"),
+				fmt.Sprintf("requester %q is not allowed by the eviction policy of the namespace", requester.Name)))
+		}
+	}
+	return allErrs
+}
+
+// ValidateHeartbeatDeadline rejects heartbeat deadlines above the maximum of the policy
+func ValidateHeartbeatDeadline(heartbeatDeadlineSeconds *int32, policy *v1alpha1.EvictionPolicySpec, fldPath *field.Path) field.ErrorList {
+	if heartbeatDeadlineSeconds == nil || policy.MaxHeartbeatDeadlineSeconds == nil || *heartbeatDeadlineSeconds <= *policy.MaxHeartbeatDeadlineSeconds {
+		return nil
+	}
+	return field.ErrorList{field.Invalid(fldPath, *heartbeatDeadlineSeconds,
+		fmt.Sprintf("must be at most %d per the eviction policy of the namespace", *policy.MaxHeartbeatDeadlineSeconds))}
+}
+
+// Validate rejects policies that the controller cannot apply
+func Validate(spec *v1alpha1.EvictionPolicySpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if spec.NamespaceSelector != nil {
+		if _, err := metav1.LabelSelectorAsSelector(spec.NamespaceSelector); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("namespaceSelector"), spec.NamespaceSelector, err.Error()))
+		}
+	}
+	if spec.DefaultHeartbeatDeadlineSeconds != nil && spec.MaxHeartbeatDeadlineSeconds != nil &&
+		*spec.DefaultHeartbeatDeadlineSeconds > *spec.MaxHeartbeatDeadlineSeconds {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("defaultHeartbeatDeadlineSeconds"), *spec.DefaultHeartbeatDeadlineSeconds,
+			fmt.Sprintf("must be at most maxHeartbeatDeadlineSeconds %d", *spec.MaxHeartbeatDeadlineSeconds)))
+	}
+	return allErrs
+}
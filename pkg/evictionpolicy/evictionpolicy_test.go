@@ -0,0 +1,100 @@
+package evictionpolicy
+
+import (
+	"testing"
+
+	"code.uber.internal/apis/evictionrequest/v1alpha1"
+	evreqlisters "code.uber.internal/pkg/generated/listers/evictionrequest/v1alpha1"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	corev1listers "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/utils/ptr"
+)
+
+// invalidSelector is a namespace selector that cannot be converted to a label selector
+var invalidSelector = &metav1.LabelSelector{
+	MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "team", Operator: "Unknown"}},
+}
+
+func newListers(t *testing.T, namespaces []*corev1.Namespace, policies ...*v1alpha1.EvictionPolicy) (evreqlisters.EvictionPolicyLister, corev1listers.NamespaceLister) {
+	t.Helper()
+
+	policyIndexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+	for _, policy := range policies {
+		if err := policyIndexer.Add(policy); err != nil {
+			t.Fatal(err)
+		}
+	}
+	namespaceIndexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+	for _, namespace := range namespaces {
+		if err := namespaceIndexer.Add(namespace); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return evreqlisters.NewEvictionPolicyLister(policyIndexer), corev1listers.NewNamespaceLister(namespaceIndexer)
+}
+
+func TestResolveSkipsInvalidPolicies(t *testing.T) {
+	namespace := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "payments", Labels: map[string]string{"team": "payments"}}}
+	policyLister, namespaceLister := newListers(t, []*corev1.Namespace{namespace},
+		&v1alpha1.EvictionPolicy{
+			ObjectMeta: metav1.ObjectMeta{Name: "invalid"},
+			Spec:       v1alpha1.EvictionPolicySpec{NamespaceSelector: invalidSelector, Precedence: 100, AllowForbid: ptr.To(true)},
+		},
+		&v1alpha1.EvictionPolicy{
+			ObjectMeta: metav1.ObjectMeta{Name: "baseline"},
+			Spec:       v1alpha1.EvictionPolicySpec{AllowForbid: ptr.To(false), AllowDirectEvictionFallback: ptr.To(false)},
+		},
+	)
+
+	policy, err := Resolve(policyLister, namespaceLister, namespace.Name, zap.NewNop())
+	if err != nil {
+		t.Fatalf("Resolve() error = %v", err)
+	}
+	if ForbidAllowed(policy) || DirectEvictionFallbackAllowed(policy) {
+		t.Errorf("Resolve() = %+v, expected the valid baseline policy only", policy)
+	}
+}
+
+func TestResolveFailsWithoutNamespace(t *testing.T) {
+	policyLister, namespaceLister := newListers(t, nil, &v1alpha1.EvictionPolicy{ObjectMeta: metav1.ObjectMeta{Name: "baseline"}})
+
+	if _, err := Resolve(policyLister, namespaceLister, "missing", zap.NewNop()); err == nil {
+		t.Error("Resolve() of a missing namespace succeeded, expected an error")
+	}
+}
+
+func TestValidate(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		spec     v1alpha1.EvictionPolicySpec
+		expected []string
+	}{
+		{name: "valid", spec: v1alpha1.EvictionPolicySpec{
+			NamespaceSelector:               &metav1.LabelSelector{MatchLabels: map[string]string{"team": "payments"}},
+			DefaultHeartbeatDeadlineSeconds: ptr.To[int32](600),
+			MaxHeartbeatDeadlineSeconds:     ptr.To[int32](600),
+		}},
+		{name: "invalid selector", spec: v1alpha1.EvictionPolicySpec{NamespaceSelector: invalidSelector},
+			expected: []string{"spec.namespaceSelector"}},
+		{name: "default above max", spec: v1alpha1.EvictionPolicySpec{
+			DefaultHeartbeatDeadlineSeconds: ptr.To[int32](3600),
+			MaxHeartbeatDeadlineSeconds:     ptr.To[int32](600),
+		}, expected: []string{"spec.defaultHeartbeatDeadlineSeconds"}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			allErrs := Validate(&tc.spec, field.NewPath("spec"))
+			if len(allErrs) != len(tc.expected) {
+				t.Fatalf("Validate() = %v, expected errors for %v", allErrs, tc.expected)
+			}
+			for i, err := range allErrs {
+				if err.Field != tc.expected[i] {
+					t.Errorf("Validate() error %d is for %s, expected %s", i, err.Field, tc.expected[i])
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,208 @@
+// Package evictionschedule contains helpers shared by the controller and the webhook to interpret
+// EvictionSchedule objects.
+package evictionschedule
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"code.uber.internal/apis/evictionrequest/v1alpha1"
+	"github.com/robfig/cron/v3"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+const (
+	// ReasonOutsideMaintenanceWindow means that none of the maintenance windows of a schedule is open
+	ReasonOutsideMaintenanceWindow = "OutsideMaintenanceWindow"
+	// ReasonFreezePeriod means that a freeze period of a schedule is active
+	ReasonFreezePeriod = "FreezePeriod"
+	// ReasonInvalidSchedule means that a schedule cannot be evaluated, so evictions are held back
+	ReasonInvalidSchedule = "InvalidEvictionSchedule"
+)
+
+// _parser accepts the standard five field cron format and descriptors such as @daily
+var _parser = cron.NewParser(cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow | cron.Descriptor)
+
+// Result is the outcome of evaluating eviction schedules
+type Result struct {
+	// Allowed is true if the pod may be evicted now
+	Allowed bool
+	// Reason is OutsideMaintenanceWindow, FreezePeriod or InvalidEvictionSchedule
+	Reason string
+	// Message names the schedule and window holding back the eviction
+	Message string
+	// Until is the earliest time the eviction may be allowed, or zero if unknown
+	Until time.Time
+}
+
+// Validate checks the time zone and cron schedules of an EvictionSchedule
+func Validate(spec *v1alpha1.EvictionScheduleSpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if spec.TimeZone != nil {
+		if _, err := time.LoadLocation(*spec.TimeZone); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("timeZone"), *spec.TimeZone, err.Error()))
+		}
+	}
+	for _, selector := range []struct {
+		name     string
+		selector *metav1.LabelSelector
+	}{{"namespaceSelector", spec.NamespaceSelector}, {"podSelector", spec.PodSelector}} {
+		if _, err := metav1.LabelSelectorAsSelector(selector.selector); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child(selector.name), selector.selector, err.Error()))
+		}
+	}
+	allErrs = append(allErrs, validateWindows(spec.MaintenanceWindows, fldPath.Child("maintenanceWindows"))...)
+	allErrs = append(allErrs, validateWindows(spec.FreezePeriods, fldPath.Child("freezePeriods"))...)
+	return allErrs
+}
+
+func validateWindows(windows []v1alpha1.ScheduleWindow, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	for i, window := range windows {
+		if _, err := parse(window.Schedule); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Index(i).Child("schedule"), window.Schedule, err.Error()))
+		}
+	}
+	return allErrs
+}
+
+// parse parses a cron schedule. Time zone prefixes are rejected, the time zone is set by .spec.timeZone.
+func parse(schedule string) (cron.Schedule, error) {
+	if strings.HasPrefix(schedule, "TZ=") || strings.HasPrefix(schedule, "CRON_TZ=") {
+		return nil, fmt.Errorf("time zone prefixes are not supported, use .spec.timeZone")
+	}
+	return _parser.Parse(schedule)
+}
+
+// Matches reports whether the schedule selects the pod in the namespace
+func Matches(schedule *v1alpha1.EvictionSchedule, namespace *corev1.Namespace, pod *corev1.Pod) (bool, error) {
+	namespaceSelector, err := selectorOrEverything(schedule.Spec.NamespaceSelector)
+	if err != nil {
+		return false, err
+	}
+	podSelector, err := selectorOrEverything(schedule.Spec.PodSelector)
+	if err != nil {
+		return false, err
+	}
+	return namespaceSelector.Matches(labels.Set(namespace.Labels)) && podSelector.Matches(labels.Set(pod.Labels)), nil
+}
+
+func selectorOrEverything(selector *metav1.LabelSelector) (labels.Selector, error) {
+	if selector == nil {
+		return labels.Everything(), nil
+	}
+	return metav1.LabelSelectorAsSelector(selector)
+}
+
+// Evaluate returns whether the schedules allow an eviction at the given time. An eviction is allowed if no
+// freeze period is active and, for schedules with maintenance windows, one of them is open. Otherwise the
+// schedule holding back the eviction the longest is reported.
+func Evaluate(schedules []*v1alpha1.EvictionSchedule, now time.Time) Result {
+	sorted := append([]*v1alpha1.EvictionSchedule(nil), schedules...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Name < sorted[j].Name })
+
+	result := Result{Allowed: true}
+	for _, schedule := range sorted {
+		scheduleResult := evaluate(schedule, now)
+		if scheduleResult.Allowed {
+			continue
+		}
+		// An invalid schedule holds back the eviction for an unknown time and takes precedence
+		if result.Allowed || (result.Reason != ReasonInvalidSchedule && (scheduleResult.Until.IsZero() || scheduleResult.Until.After(result.Until))) {
+			result = scheduleResult
+		}
+	}
+	return result
+}
+
+func evaluate(schedule *v1alpha1.EvictionSchedule, now time.Time) Result {
+	location := time.UTC
+	if schedule.Spec.TimeZone != nil {
+		loaded, err := time.LoadLocation(*schedule.Spec.TimeZone)
+		if err != nil {
+			return invalid(schedule, err)
+		}
+		location = loaded
+	}
+	now = now.In(location)
+
+	var frozenUntil time.Time
+	var freeze string
+	for i, window := range schedule.Spec.FreezePeriods {
+		parsed, err := parse(window.Schedule)
+		if err != nil {
+			return invalid(schedule, err)
+		}
+		if end, active := activeUntil(parsed, window, now); active && end.After(frozenUntil) {
+			frozenUntil = end
+			freeze = windowName(window, "freezePeriods", i)
+		}
+	}
+	if !frozenUntil.IsZero() {
+		return Result{
+			Reason: ReasonFreezePeriod,
+			Message: fmt.Sprintf("Evictions are frozen by %s of EvictionSchedule %s until %s",
+				freeze, schedule.Name, frozenUntil.UTC().Format(time.RFC3339)),
+			Until: frozenUntil,
+		}
+	}
+
+	if len(schedule.Spec.MaintenanceWindows) == 0 {
+		return Result{Allowed: true}
+	}
+	var opensAt time.Time
+	var next string
+	for i, window := range schedule.Spec.MaintenanceWindows {
+		parsed, err := parse(window.Schedule)
+		if err != nil {
+			return invalid(schedule, err)
+		}
+		if _, active := activeUntil(parsed, window, now); active {
+			return Result{Allowed: true}
+		}
+		if start := parsed.Next(now); !start.IsZero() && (opensAt.IsZero() || start.Before(opensAt)) {
+			opensAt = start
+			next = windowName(window, "maintenanceWindows", i)
+		}
+	}
+
+	message := fmt.Sprintf("Waiting for a maintenance window of EvictionSchedule %s", schedule.Name)
+	if !opensAt.IsZero() {
+		message = fmt.Sprintf("Waiting for %s of EvictionSchedule %s opening at %s",
+			next, schedule.Name, opensAt.UTC().Format(time.RFC3339))
+	}
+	return Result{
+		Reason:  ReasonOutsideMaintenanceWindow,
+		Message: message,
+		Until:   opensAt,
+	}
+}
+
+// activeUntil returns the end of the occurrence of the window containing now, if any
+func activeUntil(schedule cron.Schedule, window v1alpha1.ScheduleWindow, now time.Time) (time.Time, bool) {
+	duration := time.Duration(window.DurationSeconds) * time.Second
+	start := schedule.Next(now.Add(-duration))
+	if start.IsZero() || start.After(now) {
+		return time.Time{}, false
+	}
+	return start.Add(duration), true
+}
+
+func windowName(window v1alpha1.ScheduleWindow, list string, index int) string {
+	if window.Name != "" {
+		return fmt.Sprintf("window %q", window.Name)
+	}
+	return fmt.Sprintf("%s[%d]", list, index)
+}
+
+func invalid(schedule *v1alpha1.EvictionSchedule, err error) Result {
+	return Result{
+		Reason:  ReasonInvalidSchedule,
+		Message: fmt.Sprintf("EvictionSchedule %s is invalid: %v", schedule.Name, err),
+	}
+}
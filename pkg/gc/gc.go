@@ -0,0 +1,160 @@
+// Package gc deletes complete eviction requests once their TTL after completion expired, like the TTL
+// controller does for finished Jobs.
+package gc
+
+import (
+	"context"
+	"expvar"
+	"time"
+
+	"code.uber.internal/apis/evictionrequest/v1alpha1"
+	"code.uber.internal/pkg/config"
+	"code.uber.internal/pkg/evictionpolicy"
+	"code.uber.internal/pkg/generated/clientset/versioned"
+	evreqlisters "code.uber.internal/pkg/generated/listers/evictionrequest/v1alpha1"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/wait"
+	corev1listers "k8s.io/client-go/listers/core/v1"
+	"k8s.io/utils/clock"
+)
+
+var (
+	// _deleted counts the eviction requests deleted after their TTL expired
+	_deleted = expvar.NewInt("evictionrequest_ttl_deleted_total")
+	// _deleteErrors counts the failed deletions of expired eviction requests
+	_deleteErrors = expvar.NewInt("evictionrequest_ttl_delete_errors_total")
+	// _deletionDelaySeconds sums the time between the expiry and the deletion of eviction requests
+	_deletionDelaySeconds = expvar.NewFloat("evictionrequest_ttl_deletion_delay_seconds_total")
+)
+
+type Interface interface {
+	// Run deletes expired eviction requests periodically until the context is done
+	Run(ctx context.Context)
+}
+
+type collector struct {
+	EvictionRequestLister evreqlisters.EvictionRequestLister
+	EvictionPolicyLister  evreqlisters.EvictionPolicyLister
+	PodLister             corev1listers.PodLister
+	NamespaceLister       corev1listers.NamespaceLister
+	EvictionRequestClient versioned.Interface
+	Options               config.Options
+	Logger                *zap.Logger
+	Clock                 clock.Clock
+}
+
+func New(params params) Interface {
+	return &collector{
+		EvictionRequestLister: params.EvictionRequestLister,
+		EvictionPolicyLister:  params.EvictionPolicyLister,
+		PodLister:             params.PodLister,
+		NamespaceLister:       params.NamespaceLister,
+		EvictionRequestClient: params.EvictionRequestClient,
+		Options:               params.Options,
+		Logger:                params.Logger,
+		Clock:                 params.Clock,
+	}
+}
+
+type params struct {
+	fx.In
+
+	EvictionRequestLister evreqlisters.EvictionRequestLister
+	EvictionPolicyLister  evreqlisters.EvictionPolicyLister
+	PodLister             corev1listers.PodLister
+	NamespaceLister       corev1listers.NamespaceLister
+	EvictionRequestClient versioned.Interface
+	Options               config.Options
+	Logger                *zap.Logger
+	Clock                 clock.Clock
+}
+
+// Run deletes expired eviction requests every GCInterval
+func (c *collector) Run(ctx context.Context) {
+	c.Logger.Info("Starting eviction request garbage collection", zap.Duration("interval", c.Options.GCInterval))
+	wait.UntilWithContext(ctx, c.collect, c.Options.GCInterval)
+}
+
+// collect deletes the eviction requests whose TTL after completion expired
+func (c *collector) collect(ctx context.Context) {
+	evictionRequests, err := c.EvictionRequestLister.List(labels.Everything())
+	if err != nil {
+		c.Logger.Error("Failed to list eviction requests", zap.Error(err))
+		return
+	}
+
+	now := c.Clock.Now()
+	for _, evictionRequest := range evictionRequests {
+		expiry, ok := c.expiry(evictionRequest)
+		if !ok || now.Before(expiry) || !c.deletable(evictionRequest) {
+			continue
+		}
+		c.delete(ctx, evictionRequest, now.Sub(expiry))
+	}
+}
+
+// expiry returns when a complete eviction request expires, or false if it does not
+func (c *collector) expiry(evictionRequest *v1alpha1.EvictionRequest) (time.Time, bool) {
+	// Deletion is already in progress, e.g. waiting for finalizers
+	if evictionRequest.DeletionTimestamp != nil {
+		return time.Time{}, false
+	}
+	complete := meta.FindStatusCondition(evictionRequest.Status.Conditions, string(v1alpha1.EvictionRequestComplete))
+	if complete == nil || complete.Status != metav1.ConditionTrue {
+		return time.Time{}, false
+	}
+
+	policy, err := evictionpolicy.Resolve(c.EvictionPolicyLister, c.NamespaceLister, evictionRequest.Namespace, c.Logger)
+	if err != nil {
+		c.Logger.Warn("Failed to resolve eviction policy", zap.String("namespace", evictionRequest.Namespace), zap.Error(err))
+	}
+	ttl, ok := evictionpolicy.TTLAfterCompletion(evictionRequest, policy)
+	if !ok {
+		return time.Time{}, false
+	}
+	return complete.LastTransitionTime.Add(ttl), true
+}
+
+// deletable reports whether an eviction request may be deleted. Eviction requests forbidding their
+// cancellation are kept while the pod exists.
+func (c *collector) deletable(evictionRequest *v1alpha1.EvictionRequest) bool {
+	if evictionRequest.Status.EvictionRequestCancellationPolicy != v1alpha1.Forbid || evictionRequest.Spec.Target.PodRef == nil {
+		return true
+	}
+	pod, err := c.PodLister.Pods(evictionRequest.Namespace).Get(evictionRequest.Spec.Target.PodRef.Name)
+	if apierrors.IsNotFound(err) {
+		return true
+	}
+	return err == nil && string(pod.UID) != evictionRequest.Spec.Target.PodRef.UID
+}
+
+// delete deletes an expired eviction request unless it changed since it was observed
+func (c *collector) delete(ctx context.Context, evictionRequest *v1alpha1.EvictionRequest, delay time.Duration) {
+	logger := c.Logger.With(zap.String("name", evictionRequest.Name), zap.String("namespace", evictionRequest.Namespace))
+	policy := metav1.DeletePropagationBackground
+	err := c.EvictionRequestClient.EvictionrequestV1alpha1().EvictionRequests(evictionRequest.Namespace).Delete(ctx, evictionRequest.Name, metav1.DeleteOptions{
+		Preconditions: &metav1.Preconditions{
+			UID:             &evictionRequest.UID,
+			ResourceVersion: &evictionRequest.ResourceVersion,
+		},
+		PropagationPolicy: &policy,
+	})
+	if apierrors.IsNotFound(err) {
+		return
+	}
+	if err != nil {
+		// A conflict means the eviction request changed, it is evaluated again in the next run
+		logger.Warn("Failed to delete expired eviction request", zap.Error(err))
+		_deleteErrors.Add(1)
+		return
+	}
+
+	logger.Info("Deleted expired eviction request", zap.Duration("delay", delay))
+	_deleted.Add(1)
+	_deletionDelaySeconds.Add(delay.Seconds())
+}
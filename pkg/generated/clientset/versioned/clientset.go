@@ -7,6 +7,7 @@ import (
 	http "net/http"
 
 	evictionrequestv1alpha1 "code.uber.internal/pkg/generated/clientset/versioned/typed/evictionrequest/v1alpha1"
+	evictionrequestv1beta1 "code.uber.internal/pkg/generated/clientset/versioned/typed/evictionrequest/v1beta1"
 	discovery "k8s.io/client-go/discovery"
 	rest "k8s.io/client-go/rest"
 	flowcontrol "k8s.io/client-go/util/flowcontrol"
@@ -15,12 +16,14 @@ import (
 type Interface interface {
 	Discovery() discovery.DiscoveryInterface
 	EvictionrequestV1alpha1() evictionrequestv1alpha1.EvictionrequestV1alpha1Interface
+	EvictionrequestV1beta1() evictionrequestv1beta1.EvictionrequestV1beta1Interface
 }
 
 // Clientset contains the clients for groups.
 type Clientset struct {
 	*discovery.DiscoveryClient
 	evictionrequestV1alpha1 *evictionrequestv1alpha1.EvictionrequestV1alpha1Client
+	evictionrequestV1beta1  *evictionrequestv1beta1.EvictionrequestV1beta1Client
 }
 
 // EvictionrequestV1alpha1 retrieves the EvictionrequestV1alpha1Client
@@ -28,6 +31,11 @@ func (c *Clientset) EvictionrequestV1alpha1() evictionrequestv1alpha1.Evictionre
 	return c.evictionrequestV1alpha1
 }
 
+// EvictionrequestV1beta1 retrieves the EvictionrequestV1beta1Client
+func (c *Clientset) EvictionrequestV1beta1() evictionrequestv1beta1.EvictionrequestV1beta1Interface {
+	return c.evictionrequestV1beta1
+}
+
 // Discovery retrieves the DiscoveryClient
 func (c *Clientset) Discovery() discovery.DiscoveryInterface {
 	if c == nil {
@@ -76,6 +84,10 @@ func NewForConfigAndClient(c *rest.Config, httpClient *http.Client) (*Clientset,
 	if err != nil {
 		return nil, err
 	}
+	cs.evictionrequestV1beta1, err = evictionrequestv1beta1.NewForConfigAndClient(&configShallowCopy, httpClient)
+	if err != nil {
+		return nil, err
+	}
 
 	cs.DiscoveryClient, err = discovery.NewDiscoveryClientForConfigAndClient(&configShallowCopy, httpClient)
 	if err != nil {
@@ -98,6 +110,7 @@ func NewForConfigOrDie(c *rest.Config) *Clientset {
 func New(c rest.Interface) *Clientset {
 	var cs Clientset
 	cs.evictionrequestV1alpha1 = evictionrequestv1alpha1.New(c)
+	cs.evictionrequestV1beta1 = evictionrequestv1beta1.New(c)
 
 	cs.DiscoveryClient = discovery.NewDiscoveryClient(c)
 	return &cs
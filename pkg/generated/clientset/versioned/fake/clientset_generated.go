@@ -6,6 +6,8 @@ import (
 	clientset "code.uber.internal/pkg/generated/clientset/versioned"
 	evictionrequestv1alpha1 "code.uber.internal/pkg/generated/clientset/versioned/typed/evictionrequest/v1alpha1"
 	fakeevictionrequestv1alpha1 "code.uber.internal/pkg/generated/clientset/versioned/typed/evictionrequest/v1alpha1/fake"
+	evictionrequestv1beta1 "code.uber.internal/pkg/generated/clientset/versioned/typed/evictionrequest/v1beta1"
+	fakeevictionrequestv1beta1 "code.uber.internal/pkg/generated/clientset/versioned/typed/evictionrequest/v1beta1/fake"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/watch"
@@ -76,3 +78,8 @@ var (
 func (c *Clientset) EvictionrequestV1alpha1() evictionrequestv1alpha1.EvictionrequestV1alpha1Interface {
 	return &fakeevictionrequestv1alpha1.FakeEvictionrequestV1alpha1{Fake: &c.Fake}
 }
+
+// EvictionrequestV1beta1 retrieves the EvictionrequestV1beta1Client
+func (c *Clientset) EvictionrequestV1beta1() evictionrequestv1beta1.EvictionrequestV1beta1Interface {
+	return &fakeevictionrequestv1beta1.FakeEvictionrequestV1beta1{Fake: &c.Fake}
+}
@@ -6,6 +6,7 @@ import (
 	context "context"
 
 	evictionrequestv1alpha1 "code.uber.internal/apis/evictionrequest/v1alpha1"
+	applyconfigurationevictionrequestv1alpha1 "code.uber.internal/pkg/generated/applyconfiguration/evictionrequest/v1alpha1"
 	scheme "code.uber.internal/pkg/generated/clientset/versioned/scheme"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	types "k8s.io/apimachinery/pkg/types"
@@ -31,18 +32,21 @@ type EvictionRequestInterface interface {
 	List(ctx context.Context, opts v1.ListOptions) (*evictionrequestv1alpha1.EvictionRequestList, error)
 	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
 	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *evictionrequestv1alpha1.EvictionRequest, err error)
+	Apply(ctx context.Context, evictionRequest *applyconfigurationevictionrequestv1alpha1.EvictionRequestApplyConfiguration, opts v1.ApplyOptions) (result *evictionrequestv1alpha1.EvictionRequest, err error)
+	// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+	ApplyStatus(ctx context.Context, evictionRequest *applyconfigurationevictionrequestv1alpha1.EvictionRequestApplyConfiguration, opts v1.ApplyOptions) (result *evictionrequestv1alpha1.EvictionRequest, err error)
 	EvictionRequestExpansion
 }
 
 // evictionRequests implements EvictionRequestInterface
 type evictionRequests struct {
-	*gentype.ClientWithList[*evictionrequestv1alpha1.EvictionRequest, *evictionrequestv1alpha1.EvictionRequestList]
+	*gentype.ClientWithListAndApply[*evictionrequestv1alpha1.EvictionRequest, *evictionrequestv1alpha1.EvictionRequestList, *applyconfigurationevictionrequestv1alpha1.EvictionRequestApplyConfiguration]
 }
 
 // newEvictionRequests returns a EvictionRequests
 func newEvictionRequests(c *EvictionrequestV1alpha1Client, namespace string) *evictionRequests {
 	return &evictionRequests{
-		gentype.NewClientWithList[*evictionrequestv1alpha1.EvictionRequest, *evictionrequestv1alpha1.EvictionRequestList](
+		gentype.NewClientWithListAndApply[*evictionrequestv1alpha1.EvictionRequest, *evictionrequestv1alpha1.EvictionRequestList, *applyconfigurationevictionrequestv1alpha1.EvictionRequestApplyConfiguration](
 			"evictionrequests",
 			c.RESTClient(),
 			scheme.ParameterCodec,
@@ -12,7 +12,11 @@ import (
 
 type EvictionrequestV1alpha1Interface interface {
 	RESTClient() rest.Interface
+	EvictionAuthorizationPoliciesGetter
+	EvictionPoliciesGetter
 	EvictionRequestsGetter
+	EvictionSchedulesGetter
+	InterceptorClassesGetter
 }
 
 // EvictionrequestV1alpha1Client is used to interact with features provided by the evictionrequest group.
@@ -20,10 +24,26 @@ type EvictionrequestV1alpha1Client struct {
 	restClient rest.Interface
 }
 
+func (c *EvictionrequestV1alpha1Client) EvictionAuthorizationPolicies() EvictionAuthorizationPolicyInterface {
+	return newEvictionAuthorizationPolicies(c)
+}
+
+func (c *EvictionrequestV1alpha1Client) EvictionPolicies() EvictionPolicyInterface {
+	return newEvictionPolicies(c)
+}
+
 func (c *EvictionrequestV1alpha1Client) EvictionRequests(namespace string) EvictionRequestInterface {
 	return newEvictionRequests(c, namespace)
 }
 
+func (c *EvictionrequestV1alpha1Client) EvictionSchedules() EvictionScheduleInterface {
+	return newEvictionSchedules(c)
+}
+
+func (c *EvictionrequestV1alpha1Client) InterceptorClasses() InterceptorClassInterface {
+	return newInterceptorClasses(c)
+}
+
 // NewForConfig creates a new EvictionrequestV1alpha1Client for the given config.
 // NewForConfig is equivalent to NewForConfigAndClient(c, httpClient),
 // where httpClient was generated with rest.HTTPClientFor(c).
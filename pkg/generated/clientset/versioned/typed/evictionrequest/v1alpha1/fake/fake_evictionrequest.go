@@ -4,19 +4,20 @@ package fake
 
 import (
 	v1alpha1 "code.uber.internal/apis/evictionrequest/v1alpha1"
-	evictionrequestv1alpha1 "code.uber.internal/pkg/generated/clientset/versioned/typed/evictionrequest/v1alpha1"
+	evictionrequestv1alpha1 "code.uber.internal/pkg/generated/applyconfiguration/evictionrequest/v1alpha1"
+	typedevictionrequestv1alpha1 "code.uber.internal/pkg/generated/clientset/versioned/typed/evictionrequest/v1alpha1"
 	gentype "k8s.io/client-go/gentype"
 )
 
 // fakeEvictionRequests implements EvictionRequestInterface
 type fakeEvictionRequests struct {
-	*gentype.FakeClientWithList[*v1alpha1.EvictionRequest, *v1alpha1.EvictionRequestList]
+	*gentype.FakeClientWithListAndApply[*v1alpha1.EvictionRequest, *v1alpha1.EvictionRequestList, *evictionrequestv1alpha1.EvictionRequestApplyConfiguration]
 	Fake *FakeEvictionrequestV1alpha1
 }
 
-func newFakeEvictionRequests(fake *FakeEvictionrequestV1alpha1, namespace string) evictionrequestv1alpha1.EvictionRequestInterface {
+func newFakeEvictionRequests(fake *FakeEvictionrequestV1alpha1, namespace string) typedevictionrequestv1alpha1.EvictionRequestInterface {
 	return &fakeEvictionRequests{
-		gentype.NewFakeClientWithList[*v1alpha1.EvictionRequest, *v1alpha1.EvictionRequestList](
+		gentype.NewFakeClientWithListAndApply[*v1alpha1.EvictionRequest, *v1alpha1.EvictionRequestList, *evictionrequestv1alpha1.EvictionRequestApplyConfiguration](
 			fake.Fake,
 			namespace,
 			v1alpha1.SchemeGroupVersion.WithResource("evictionrequests"),
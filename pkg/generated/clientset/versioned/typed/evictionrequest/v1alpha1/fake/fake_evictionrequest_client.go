@@ -12,10 +12,26 @@ type FakeEvictionrequestV1alpha1 struct {
 	*testing.Fake
 }
 
+func (c *FakeEvictionrequestV1alpha1) EvictionAuthorizationPolicies() v1alpha1.EvictionAuthorizationPolicyInterface {
+	return newFakeEvictionAuthorizationPolicies(c)
+}
+
+func (c *FakeEvictionrequestV1alpha1) EvictionPolicies() v1alpha1.EvictionPolicyInterface {
+	return newFakeEvictionPolicies(c)
+}
+
 func (c *FakeEvictionrequestV1alpha1) EvictionRequests(namespace string) v1alpha1.EvictionRequestInterface {
 	return newFakeEvictionRequests(c, namespace)
 }
 
+func (c *FakeEvictionrequestV1alpha1) EvictionSchedules() v1alpha1.EvictionScheduleInterface {
+	return newFakeEvictionSchedules(c)
+}
+
+func (c *FakeEvictionrequestV1alpha1) InterceptorClasses() v1alpha1.InterceptorClassInterface {
+	return newFakeInterceptorClasses(c)
+}
+
 // RESTClient returns a RESTClient that is used to communicate
 // with API server by this client implementation.
 func (c *FakeEvictionrequestV1alpha1) RESTClient() rest.Interface {
@@ -2,4 +2,12 @@
 
 package v1alpha1
 
+type EvictionAuthorizationPolicyExpansion interface{}
+
+type EvictionPolicyExpansion interface{}
+
 type EvictionRequestExpansion interface{}
+
+type EvictionScheduleExpansion interface{}
+
+type InterceptorClassExpansion interface{}
@@ -8,8 +8,16 @@ import (
 
 // Interface provides access to all the informers in this group version.
 type Interface interface {
+	// EvictionAuthorizationPolicies returns a EvictionAuthorizationPolicyInformer.
+	EvictionAuthorizationPolicies() EvictionAuthorizationPolicyInformer
+	// EvictionPolicies returns a EvictionPolicyInformer.
+	EvictionPolicies() EvictionPolicyInformer
 	// EvictionRequests returns a EvictionRequestInformer.
 	EvictionRequests() EvictionRequestInformer
+	// EvictionSchedules returns a EvictionScheduleInformer.
+	EvictionSchedules() EvictionScheduleInformer
+	// InterceptorClasses returns a InterceptorClassInformer.
+	InterceptorClasses() InterceptorClassInformer
 }
 
 type version struct {
@@ -23,7 +31,27 @@ func New(f internalinterfaces.SharedInformerFactory, namespace string, tweakList
 	return &version{factory: f, namespace: namespace, tweakListOptions: tweakListOptions}
 }
 
+// EvictionAuthorizationPolicies returns a EvictionAuthorizationPolicyInformer.
+func (v *version) EvictionAuthorizationPolicies() EvictionAuthorizationPolicyInformer {
+	return &evictionAuthorizationPolicyInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
+// EvictionPolicies returns a EvictionPolicyInformer.
+func (v *version) EvictionPolicies() EvictionPolicyInformer {
+	return &evictionPolicyInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
 // EvictionRequests returns a EvictionRequestInformer.
 func (v *version) EvictionRequests() EvictionRequestInformer {
 	return &evictionRequestInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
+
+// EvictionSchedules returns a EvictionScheduleInformer.
+func (v *version) EvictionSchedules() EvictionScheduleInformer {
+	return &evictionScheduleInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
+// InterceptorClasses returns a InterceptorClassInformer.
+func (v *version) InterceptorClasses() InterceptorClassInformer {
+	return &interceptorClassInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
@@ -6,6 +6,7 @@ import (
 	fmt "fmt"
 
 	v1alpha1 "code.uber.internal/apis/evictionrequest/v1alpha1"
+	v1beta1 "code.uber.internal/apis/evictionrequest/v1beta1"
 	schema "k8s.io/apimachinery/pkg/runtime/schema"
 	cache "k8s.io/client-go/tools/cache"
 )
@@ -37,8 +38,20 @@ func (f *genericInformer) Lister() cache.GenericLister {
 func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource) (GenericInformer, error) {
 	switch resource {
 	// Group=evictionrequest, Version=v1alpha1
+	case v1alpha1.SchemeGroupVersion.WithResource("evictionauthorizationpolicies"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Evictionrequest().V1alpha1().EvictionAuthorizationPolicies().Informer()}, nil
+	case v1alpha1.SchemeGroupVersion.WithResource("evictionpolicies"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Evictionrequest().V1alpha1().EvictionPolicies().Informer()}, nil
 	case v1alpha1.SchemeGroupVersion.WithResource("evictionrequests"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Evictionrequest().V1alpha1().EvictionRequests().Informer()}, nil
+	case v1alpha1.SchemeGroupVersion.WithResource("evictionschedules"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Evictionrequest().V1alpha1().EvictionSchedules().Informer()}, nil
+	case v1alpha1.SchemeGroupVersion.WithResource("interceptorclasses"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Evictionrequest().V1alpha1().InterceptorClasses().Informer()}, nil
+
+		// Group=evictionrequest, Version=v1beta1
+	case v1beta1.SchemeGroupVersion.WithResource("evictionrequests"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Evictionrequest().V1beta1().EvictionRequests().Informer()}, nil
 
 	}
 
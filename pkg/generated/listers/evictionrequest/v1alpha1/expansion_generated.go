@@ -2,6 +2,14 @@
 
 package v1alpha1
 
+// EvictionAuthorizationPolicyListerExpansion allows custom methods to be added to
+// EvictionAuthorizationPolicyLister.
+type EvictionAuthorizationPolicyListerExpansion interface{}
+
+// EvictionPolicyListerExpansion allows custom methods to be added to
+// EvictionPolicyLister.
+type EvictionPolicyListerExpansion interface{}
+
 // EvictionRequestListerExpansion allows custom methods to be added to
 // EvictionRequestLister.
 type EvictionRequestListerExpansion interface{}
@@ -9,3 +17,11 @@ type EvictionRequestListerExpansion interface{}
 // EvictionRequestNamespaceListerExpansion allows custom methods to be added to
 // EvictionRequestNamespaceLister.
 type EvictionRequestNamespaceListerExpansion interface{}
+
+// EvictionScheduleListerExpansion allows custom methods to be added to
+// EvictionScheduleLister.
+type EvictionScheduleListerExpansion interface{}
+
+// InterceptorClassListerExpansion allows custom methods to be added to
+// InterceptorClassLister.
+type InterceptorClassListerExpansion interface{}
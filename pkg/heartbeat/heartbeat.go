@@ -0,0 +1,105 @@
+// Package heartbeat lets interceptors heartbeat through a coordination.k8s.io Lease per eviction request
+// instead of .status.heartbeatTime. Renewing a Lease does not update the eviction request, so it does not
+// trigger a reconcile: the controller reads the Lease when the heartbeat deadline of the active interceptor
+// is checked.
+package heartbeat
+
+import (
+	"reflect"
+
+	"code.uber.internal/apis/evictionrequest/v1alpha1"
+	"code.uber.internal/pkg/config"
+	"code.uber.internal/pkg/constants"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+	coordinationv1listers "k8s.io/client-go/listers/coordination/v1"
+)
+
+const (
+	// LeaseLabel marks the heartbeat Leases of interceptors. The controller only watches Leases with this label.
+	LeaseLabel = "evictionrequest.coordination.uber.com/heartbeat"
+
+	_leaseNamePrefix = "evictionrequest-"
+)
+
+// LeaseName returns the name of the heartbeat Lease of an eviction request. The Lease lives in the namespace
+// of the eviction request and is named after its UID, so a recreated eviction request gets a new Lease.
+func LeaseName(evictionRequest *v1alpha1.EvictionRequest) string {
+	return _leaseNamePrefix + string(evictionRequest.UID)
+}
+
+type Interface interface {
+	// Start starts watching the heartbeat Leases and waits for the cache to sync. It returns the sync state
+	// by informer type, which is empty if Lease heartbeats are disabled.
+	Start(stopCh <-chan struct{}) map[reflect.Type]bool
+	// RenewTime returns the last renewal of the heartbeat Lease of the eviction request by its active
+	// interceptor, or nil if there is none
+	RenewTime(evictionRequest *v1alpha1.EvictionRequest) *metav1.Time
+}
+
+type heartbeat struct {
+	factory informers.SharedInformerFactory
+	lister  coordinationv1listers.LeaseLister
+	logger  *zap.Logger
+}
+
+type params struct {
+	fx.In
+
+	KubeClient kubernetes.Interface
+	Options    config.Options
+	Logger     *zap.Logger
+}
+
+// New creates the watcher of heartbeat Leases. It does not watch anything if Lease heartbeats are disabled.
+func New(params params) Interface {
+	h := &heartbeat{logger: params.Logger}
+	if !params.Options.LeaseHeartbeats {
+		return h
+	}
+
+	h.factory = informers.NewSharedInformerFactoryWithOptions(params.KubeClient, constants.DefaultResyncInterval,
+		informers.WithTweakListOptions(func(options *metav1.ListOptions) {
+			options.LabelSelector = LeaseLabel
+		}),
+	)
+	h.lister = h.factory.Coordination().V1().Leases().Lister()
+	return h
+}
+
+// Start implements Interface
+func (h *heartbeat) Start(stopCh <-chan struct{}) map[reflect.Type]bool {
+	if h.factory == nil {
+		return nil
+	}
+	h.factory.Start(stopCh)
+	return h.factory.WaitForCacheSync(stopCh)
+}
+
+// RenewTime implements Interface. Leases held by another interceptor class than the active one are ignored,
+// so a previous interceptor cannot keep its successor alive. The holder identity can be trusted as far as the
+// EvictionAuthorizationPolicies go: the webhook only lets the users authorized for an interceptor class hold a
+// Lease in its name, but a class that no policy lists, e.g. any class when there are no policies, may be held by
+// any user allowed to write Leases in the namespace.
+func (h *heartbeat) RenewTime(evictionRequest *v1alpha1.EvictionRequest) *metav1.Time {
+	activeInterceptorClass := evictionRequest.Status.ActiveInterceptorClass
+	if h.lister == nil || activeInterceptorClass == nil {
+		return nil
+	}
+
+	lease, err := h.lister.Leases(evictionRequest.Namespace).Get(LeaseName(evictionRequest))
+	if err != nil {
+		if !apierrors.IsNotFound(err) {
+			h.logger.Warn("Failed to get heartbeat lease", zap.String("eviction_request", evictionRequest.Name), zap.Error(err))
+		}
+		return nil
+	}
+	if lease.Spec.RenewTime == nil || lease.Spec.HolderIdentity == nil || *lease.Spec.HolderIdentity != *activeInterceptorClass {
+		return nil
+	}
+	return &metav1.Time{Time: lease.Spec.RenewTime.Time}
+}
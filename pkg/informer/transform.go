@@ -0,0 +1,62 @@
+package informer
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Trim is a cache.TransformFunc that trims the objects of the kube informer factory with TrimPod and TrimNode
+func Trim(obj interface{}) (interface{}, error) {
+	if _, ok := obj.(*corev1.Node); ok {
+		return TrimNode(obj)
+	}
+	return TrimPod(obj)
+}
+
+// TrimPod is a cache.TransformFunc that strips a Pod down to the fields read by the reconciler
+// before the object is stored in the informer cache. Objects of any other type are returned unchanged.
+//
+// The function is idempotent, as required by client-go, so an already trimmed Pod is trimmed to itself.
+func TrimPod(obj interface{}) (interface{}, error) {
+	pod, ok := obj.(*corev1.Pod)
+	if !ok {
+		return obj, nil
+	}
+
+	return &corev1.Pod{
+		TypeMeta: pod.TypeMeta,
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              pod.Name,
+			Namespace:         pod.Namespace,
+			UID:               pod.UID,
+			Labels:            pod.Labels,
+			ResourceVersion:   pod.ResourceVersion,
+			DeletionTimestamp: pod.DeletionTimestamp,
+		},
+		Spec: corev1.PodSpec{
+			NodeName: pod.Spec.NodeName,
+		},
+		Status: corev1.PodStatus{
+			Phase: pod.Status.Phase,
+		},
+	}, nil
+}
+
+// TrimNode is a cache.TransformFunc that strips a Node down to its identity and labels, which hold the
+// topology read by the eviction budgets. Objects of any other type are returned unchanged.
+func TrimNode(obj interface{}) (interface{}, error) {
+	node, ok := obj.(*corev1.Node)
+	if !ok {
+		return obj, nil
+	}
+
+	return &corev1.Node{
+		TypeMeta: node.TypeMeta,
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            node.Name,
+			UID:             node.UID,
+			ResourceVersion: node.ResourceVersion,
+			Labels:          node.Labels,
+		},
+	}, nil
+}
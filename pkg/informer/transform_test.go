@@ -0,0 +1,240 @@
+package informer
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"runtime"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/cache"
+)
+
+const _benchmarkPods = 100_000
+
+func TestTrimPod(t *testing.T) {
+	pod := syntheticPod(0)
+	deletionTimestamp := metav1.NewTime(time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC))
+	pod.DeletionTimestamp = &deletionTimestamp
+	expected := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              pod.Name,
+			Namespace:         pod.Namespace,
+			UID:               pod.UID,
+			Labels:            pod.Labels,
+			ResourceVersion:   pod.ResourceVersion,
+			DeletionTimestamp: &deletionTimestamp,
+		},
+		Spec:   corev1.PodSpec{NodeName: pod.Spec.NodeName},
+		Status: corev1.PodStatus{Phase: pod.Status.Phase},
+	}
+
+	trimmed, err := TrimPod(pod)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(trimmed, expected) {
+		t.Errorf("TrimPod() = %v, expected %v", trimmed, expected)
+	}
+
+	again, err := TrimPod(trimmed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(again, expected) {
+		t.Errorf("TrimPod() is not idempotent: %v != %v", again, expected)
+	}
+
+	node := &corev1.Node{}
+	if obj, _ := TrimPod(node); obj != node {
+		t.Errorf("TrimPod() changed a %T", node)
+	}
+}
+
+func TestTrimNode(t *testing.T) {
+	node := &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            "node",
+			UID:             "node-uid",
+			ResourceVersion: "1",
+			Labels:          map[string]string{corev1.LabelTopologyZone: "zone-a"},
+			Annotations:     map[string]string{"node.alpha.kubernetes.io/ttl": "0"},
+		},
+		Spec: corev1.NodeSpec{PodCIDR: "10.0.0.0/24"},
+		Status: corev1.NodeStatus{
+			Images: []corev1.ContainerImage{{Names: []string{"registry.example.com/app:1.0.0"}, SizeBytes: 1 << 20}},
+		},
+	}
+	expected := &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            node.Name,
+			UID:             node.UID,
+			ResourceVersion: node.ResourceVersion,
+			Labels:          node.Labels,
+		},
+	}
+
+	trimmed, err := TrimNode(node)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(trimmed, expected) {
+		t.Errorf("TrimNode() = %v, expected %v", trimmed, expected)
+	}
+
+	pod := &corev1.Pod{}
+	if obj, _ := TrimNode(pod); obj != pod {
+		t.Errorf("TrimNode() changed a %T", pod)
+	}
+}
+
+// BenchmarkTrimPod lists a synthetic cluster of 100k pods through a fake client into a pod informer, with and
+// without TrimPod, and reports the heap retained by the informer cache.
+func BenchmarkTrimPod(b *testing.B) {
+	pods := make([]corev1.Pod, _benchmarkPods)
+	for i := range pods {
+		pods[i] = *syntheticPod(i)
+	}
+	kubeClient := fake.NewClientset()
+	for i := range pods {
+		if err := kubeClient.Tracker().Add(&pods[i]); err != nil {
+			b.Fatal(err)
+		}
+	}
+
+	for _, bc := range []struct {
+		name      string
+		transform cache.TransformFunc
+	}{
+		{name: "untrimmed"},
+		{name: "trimmed", transform: TrimPod},
+	} {
+		b.Run(bc.name, func(b *testing.B) {
+			for range b.N {
+				before := heapInUse()
+				store := listPods(b, kubeClient, bc.transform)
+				after := heapInUse()
+				if len(store.List()) != _benchmarkPods {
+					b.Fatalf("cached %d pods, expected %d", len(store.List()), _benchmarkPods)
+				}
+				b.ReportMetric(float64(after-before)/_benchmarkPods, "cache-B/pod")
+				runtime.KeepAlive(store)
+			}
+		})
+	}
+}
+
+// listPods syncs a pod informer with the transform and returns its cache once the informer is stopped
+func listPods(b *testing.B, kubeClient *fake.Clientset, transform cache.TransformFunc) cache.Store {
+	b.Helper()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var options []informers.SharedInformerOption
+	if transform != nil {
+		options = append(options, informers.WithTransform(transform))
+	}
+	factory := informers.NewSharedInformerFactoryWithOptions(kubeClient, 0, options...)
+	podInformer := factory.Core().V1().Pods().Informer()
+	factory.Start(ctx.Done())
+	if !cache.WaitForCacheSync(ctx.Done(), podInformer.HasSynced) {
+		b.Fatal("pod informer did not sync")
+	}
+	cancel()
+	factory.Shutdown()
+	return podInformer.GetStore()
+}
+
+// heapInUse returns the bytes in in-use heap spans, as an int64 so that a shrinking heap gives a negative delta
+func heapInUse() int64 {
+	runtime.GC()
+	var stats runtime.MemStats
+	runtime.ReadMemStats(&stats)
+	return int64(stats.HeapInuse)
+}
+
+// syntheticPod returns a pod shaped like a typical application pod, with the metadata, containers and status
+// the API server returns for it
+func syntheticPod(i int) *corev1.Pod {
+	name := fmt.Sprintf("app-%d", i)
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              name,
+			Namespace:         fmt.Sprintf("namespace-%d", i%100),
+			UID:               types.UID(fmt.Sprintf("00000000-0000-0000-0000-%012d", i)),
+			ResourceVersion:   "1",
+			CreationTimestamp: metav1.Now(),
+			Labels: map[string]string{
+				"app":               "app",
+				"pod-template-hash": "5d8f7c9b6",
+			},
+			Annotations: map[string]string{
+				"kubectl.kubernetes.io/restartedAt": "2025-01-01T00:00:00Z",
+				"prometheus.io/scrape":              "true",
+				"prometheus.io/port":                "9090",
+			},
+			OwnerReferences: []metav1.OwnerReference{{
+				APIVersion: "apps/v1",
+				Kind:       "ReplicaSet",
+				Name:       "app-5d8f7c9b6",
+				UID:        "11111111-1111-1111-1111-111111111111",
+			}},
+			ManagedFields: []metav1.ManagedFieldsEntry{{
+				Manager:    "kube-controller-manager",
+				Operation:  metav1.ManagedFieldsOperationUpdate,
+				APIVersion: "v1",
+				FieldsType: "FieldsV1",
+				FieldsV1:   &metav1.FieldsV1{Raw: make([]byte, 2048)},
+			}},
+		},
+		Spec: corev1.PodSpec{
+			NodeName:           fmt.Sprintf("node-%d", i%1000),
+			ServiceAccountName: "app",
+			Containers: []corev1.Container{{
+				Name:    "app",
+				Image:   "registry.example.com/app:1.0.0",
+				Command: []string{"/app", "--config", "/etc/app/config.yaml"},
+				Env: []corev1.EnvVar{
+					{Name: "POD_NAME", Value: name},
+					{Name: "LOG_LEVEL", Value: "info"},
+				},
+				Ports: []corev1.ContainerPort{{Name: "http", ContainerPort: 8080}, {Name: "metrics", ContainerPort: 9090}},
+				Resources: corev1.ResourceRequirements{
+					Requests: corev1.ResourceList{
+						corev1.ResourceCPU:    resource.MustParse("500m"),
+						corev1.ResourceMemory: resource.MustParse("512Mi"),
+					},
+				},
+				VolumeMounts: []corev1.VolumeMount{{Name: "config", MountPath: "/etc/app"}},
+			}},
+			Volumes: []corev1.Volume{{
+				Name: "config",
+				VolumeSource: corev1.VolumeSource{
+					ConfigMap: &corev1.ConfigMapVolumeSource{LocalObjectReference: corev1.LocalObjectReference{Name: "app"}},
+				},
+			}},
+		},
+		Status: corev1.PodStatus{
+			Phase:  corev1.PodRunning,
+			PodIP:  "10.0.0.1",
+			HostIP: "192.168.0.1",
+			Conditions: []corev1.PodCondition{
+				{Type: corev1.PodReady, Status: corev1.ConditionTrue},
+				{Type: corev1.ContainersReady, Status: corev1.ConditionTrue},
+			},
+			ContainerStatuses: []corev1.ContainerStatus{{
+				Name:    "app",
+				Ready:   true,
+				Image:   "registry.example.com/app:1.0.0",
+				ImageID: "registry.example.com/app@sha256:0123456789abcdef",
+			}},
+		},
+	}
+}
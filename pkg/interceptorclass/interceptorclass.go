@@ -0,0 +1,188 @@
+// Package interceptorclass contains helpers shared by the controller, the webhook and interceptor
+// implementations to interpret InterceptorClass objects.
+package interceptorclass
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"code.uber.internal/apis/evictionrequest/v1alpha1"
+	evreqlisters "code.uber.internal/pkg/generated/listers/evictionrequest/v1alpha1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
+)
+
+const (
+	// ReservedPriorityMin is the lowest priority reserved for interceptors of the controller's domain
+	ReservedPriorityMin = 9900
+	// ReservedPriorityMax is the highest priority reserved for interceptors of the controller's domain
+	ReservedPriorityMax = 10099
+	// MaxReservedInterceptors is the maximum number of interceptors in the reserved priority band
+	MaxReservedInterceptors = 50
+	// MaxUnreservedInterceptors is the maximum number of interceptors outside of the reserved priority band
+	MaxUnreservedInterceptors = 250
+
+	// ControllerRole is the role reserved for the managing controller of the pod
+	ControllerRole = "controller"
+
+	_defaultLivenessDeadline = 300 * time.Second
+)
+
+// Get returns the registered InterceptorClass, or nil if the class is not registered
+func Get(lister evreqlisters.InterceptorClassLister, name string) (*v1alpha1.InterceptorClass, error) {
+	class, err := lister.Get(name)
+	if apierrors.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return class, nil
+}
+
+// LivenessDeadline returns the maximum time between two liveness heartbeats of the class
+func LivenessDeadline(class *v1alpha1.InterceptorClass) time.Duration {
+	if class.Spec.LivenessDeadlineSeconds == nil {
+		return _defaultLivenessDeadline
+	}
+	return time.Duration(*class.Spec.LivenessDeadlineSeconds) * time.Second
+}
+
+// IsLive reports whether the class has a live implementation at the given time.
+// Classes with a callout are served by the controller and are always live.
+func IsLive(class *v1alpha1.InterceptorClass, now time.Time) bool {
+	if class.Spec.Callout != nil {
+		return true
+	}
+	if class.Status.HeartbeatTime == nil {
+		return false
+	}
+	return now.Sub(class.Status.HeartbeatTime.Time) <= LivenessDeadline(class)
+}
+
+// HeartbeatDeadline caps the heartbeat deadline of an EvictionRequest with the maximum allowed by the class
+func HeartbeatDeadline(class *v1alpha1.InterceptorClass, deadline time.Duration) time.Duration {
+	if class == nil || class.Spec.MaxHeartbeatDeadlineSeconds == nil {
+		return deadline
+	}
+	if max := time.Duration(*class.Spec.MaxHeartbeatDeadlineSeconds) * time.Second; max < deadline {
+		return max
+	}
+	return deadline
+}
+
+// Domain returns the domain owning an interceptor class. The domain of a registered class is taken
+// from its spec, the parent domain of the class name is used otherwise (bar.example.com -> example.com).
+func Domain(name string, class *v1alpha1.InterceptorClass) string {
+	if class != nil {
+		return class.Spec.Domain
+	}
+	if idx := strings.Index(name, "."); idx >= 0 {
+		return name[idx+1:]
+	}
+	return name
+}
+
+// IsReserved reports whether the priority is in the band reserved for the controller's domain
+func IsReserved(priority int32) bool {
+	return priority >= ReservedPriorityMin && priority <= ReservedPriorityMax
+}
+
+// ApplyDefaults sets the default priority and role of their registered class on the interceptors without one. A
+// priority of 0 is taken as unset, since typed clients cannot omit it. It returns the indexes of the interceptors
+// it changed.
+func ApplyDefaults(interceptors []v1alpha1.Interceptor, lister evreqlisters.InterceptorClassLister) ([]int, error) {
+	var changed []int
+	for idx := range interceptors {
+		interceptor := &interceptors[idx]
+		class, err := Get(lister, interceptor.InterceptorClass)
+		if err != nil {
+			return nil, err
+		}
+		if class == nil {
+			continue
+		}
+
+		defaulted := false
+		if interceptor.Priority == 0 && class.Spec.DefaultPriority != nil && *class.Spec.DefaultPriority != 0 {
+			interceptor.Priority = *class.Spec.DefaultPriority
+			defaulted = true
+		}
+		if interceptor.Role == nil && class.Spec.DefaultRole != nil {
+			interceptor.Role = ptr.To(*class.Spec.DefaultRole)
+			defaulted = true
+		}
+		if defaulted {
+			changed = append(changed, idx)
+		}
+	}
+	return changed, nil
+}
+
+// ValidateInterceptors checks the priority band and role rules of .spec.interceptors:
+// - at most one interceptor has the controller role
+// - interceptors in the reserved band share the domain of the controller interceptor
+// - priorities are unique in the reserved band
+// - at most 50 interceptors are in the reserved band and at most 250 outside of it
+func ValidateInterceptors(interceptors []v1alpha1.Interceptor, lister evreqlisters.InterceptorClassLister, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	classes := make(map[string]*v1alpha1.InterceptorClass, len(interceptors))
+	for idx, interceptor := range interceptors {
+		class, err := Get(lister, interceptor.InterceptorClass)
+		if err != nil {
+			allErrs = append(allErrs, field.InternalError(fldPath.Index(idx).Child("interceptorClass"), err))
+			continue
+		}
+		classes[interceptor.InterceptorClass] = class
+	}
+
+	controllerDomain := ""
+	for idx, interceptor := range interceptors {
+		if interceptor.Role == nil || *interceptor.Role != ControllerRole {
+			continue
+		}
+		if controllerDomain != "" {
+			allErrs = append(allErrs, field.Duplicate(fldPath.Index(idx).Child("role"), ControllerRole))
+			continue
+		}
+		controllerDomain = Domain(interceptor.InterceptorClass, classes[interceptor.InterceptorClass])
+	}
+
+	reserved, unreserved := 0, 0
+	reservedPriorities := make(map[int32]bool)
+	for idx, interceptor := range interceptors {
+		if !IsReserved(interceptor.Priority) {
+			unreserved++
+			continue
+		}
+		reserved++
+
+		priorityPath := fldPath.Index(idx).Child("priority")
+		if reservedPriorities[interceptor.Priority] {
+			allErrs = append(allErrs, field.Duplicate(priorityPath, interceptor.Priority))
+		}
+		reservedPriorities[interceptor.Priority] = true
+
+		domain := Domain(interceptor.InterceptorClass, classes[interceptor.InterceptorClass])
+		switch {
+		case controllerDomain == "":
+			allErrs = append(allErrs, field.Forbidden(priorityPath,
+				fmt.Sprintf("priorities %d-%d require an interceptor with the %q role", ReservedPriorityMin, ReservedPriorityMax, ControllerRole)))
+		case domain != controllerDomain:
+			allErrs = append(allErrs, field.Forbidden(priorityPath,
+				fmt.Sprintf("priorities %d-%d are reserved for the domain %q of the controller interceptor, got %q", ReservedPriorityMin, ReservedPriorityMax, controllerDomain, domain)))
+		}
+	}
+
+	if reserved > MaxReservedInterceptors {
+		allErrs = append(allErrs, field.TooMany(fldPath, reserved, MaxReservedInterceptors))
+	}
+	if unreserved > MaxUnreservedInterceptors {
+		allErrs = append(allErrs, field.TooMany(fldPath, unreserved, MaxUnreservedInterceptors))
+	}
+
+	return allErrs
+}
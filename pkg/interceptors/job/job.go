@@ -0,0 +1,320 @@
+// Package job implements an interceptor that runs a Job before the target pod is evicted, e.g. to drain data
+// from the pod or to deregister it from an external system.
+//
+// The Job is created from a template when an EvictionRequest is assigned to the interceptor, with the details of
+// the target pod injected as environment variables. The template is referenced by the .spec.job of the active
+// InterceptorClass, or read from JOB_TEMPLATE for classes without one. The interceptor heartbeats while the Job runs and marks itself
+// as completed once the Job succeeds. If the Job fails, the interceptor either completes anyway so that the
+// eviction proceeds, or blocks the eviction until the Job is deleted, which runs it again.
+package job
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"os"
+	"time"
+
+	"code.uber.internal/apis/evictionrequest/v1alpha1"
+	"code.uber.internal/pkg/generated/clientset/versioned"
+	"code.uber.internal/pkg/interceptorsdk"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// DefaultInterceptorClass is the interceptor class served by the Job interceptor unless configured otherwise
+	DefaultInterceptorClass = "job.evictionrequest.coordination.uber.com"
+
+	// TemplateEnv is the environment variable holding the path of the Job template, a batch/v1 Job manifest, of
+	// the interceptor classes without a .spec.job
+	TemplateEnv = "JOB_TEMPLATE"
+	// FailurePolicyEnv is the environment variable holding the FailurePolicy of the interceptor classes without a
+	// .spec.job. Defaults to FallThrough.
+	FailurePolicyEnv = "JOB_FAILURE_POLICY"
+
+	// LabelEvictionRequestUID is set on the Jobs to the UID of the EvictionRequest they run for. Unlike its name,
+	// the UID is always a valid label value.
+	LabelEvictionRequestUID = "evictionrequest.coordination.uber.com/eviction-request-uid"
+
+	_jobNamePrefix = "evictionrequest-"
+	_pollInterval  = 10 * time.Second
+)
+
+// FailurePolicy is what the interceptor does when the Job fails
+type FailurePolicy = v1alpha1.JobFailurePolicy
+
+const (
+	// FallThrough completes the interceptor, so the next interceptor is selected or the pod is evicted
+	FallThrough = v1alpha1.JobFailurePolicyFallThrough
+	// Block keeps the interceptor active and heartbeating, which blocks the eviction. Deleting the failed Job
+	// runs it again.
+	Block = v1alpha1.JobFailurePolicyBlock
+)
+
+var _ interceptorsdk.Interceptor = &Interceptor{}
+
+// Interceptor runs a Job from a template for the target pod of an EvictionRequest
+type Interceptor struct {
+	kubeClient            kubernetes.Interface
+	evictionRequestClient versioned.Interface
+	logger                *zap.Logger
+	// template and failurePolicy are used for the interceptor classes without a .spec.job. template is nil if
+	// JOB_TEMPLATE is unset.
+	template      *batchv1.Job
+	failurePolicy FailurePolicy
+	pollInterval  time.Duration
+}
+
+type params struct {
+	fx.In
+
+	KubeClient            kubernetes.Interface
+	EvictionRequestClient versioned.Interface
+	Logger                *zap.Logger
+}
+
+// New creates a Job Interceptor, reading the template of the interceptor classes without a .spec.job from
+// JOB_TEMPLATE and JOB_FAILURE_POLICY
+func New(params params) (*Interceptor, error) {
+	var template *batchv1.Job
+	if path := os.Getenv(TemplateEnv); path != "" {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read the Job template: %w", err)
+		}
+		if template, err = parseTemplate(data, path); err != nil {
+			return nil, err
+		}
+	}
+
+	failurePolicy := FailurePolicy(os.Getenv(FailurePolicyEnv))
+	switch failurePolicy {
+	case "":
+		failurePolicy = FallThrough
+	case FallThrough, Block:
+	default:
+		return nil, fmt.Errorf("invalid %s %q: must be %s or %s", FailurePolicyEnv, failurePolicy, FallThrough, Block)
+	}
+
+	return &Interceptor{
+		kubeClient:            params.KubeClient,
+		evictionRequestClient: params.EvictionRequestClient,
+		logger:                params.Logger,
+		template:              template,
+		failurePolicy:         failurePolicy,
+		pollInterval:          _pollInterval,
+	}, nil
+}
+
+// parseTemplate parses a batch/v1 Job manifest read from source
+func parseTemplate(data []byte, source string) (*batchv1.Job, error) {
+	template := &batchv1.Job{}
+	if err := yaml.UnmarshalStrict(data, template); err != nil {
+		return nil, fmt.Errorf("failed to parse the Job template %s: %w", source, err)
+	}
+	if len(template.Spec.Template.Spec.Containers) == 0 {
+		return nil, fmt.Errorf("the Job template %s has no containers", source)
+	}
+	return template, nil
+}
+
+// JobName returns the name of the Job run for an EvictionRequest. It is derived from the UID of the
+// EvictionRequest, so the Job is found again after a restart and a recreated EvictionRequest gets a new one.
+func JobName(evictionRequest *v1alpha1.EvictionRequest) string {
+	return _jobNamePrefix + string(evictionRequest.UID)
+}
+
+// OnAssigned runs the Job for the target pod and waits until it succeeds, or fails with the FallThrough policy
+func (j *Interceptor) OnAssigned(ctx context.Context, evictionRequest *v1alpha1.EvictionRequest, progress interceptorsdk.Progress) error {
+	logger := j.logger.With(zap.String("namespace", evictionRequest.Namespace), zap.String("name", evictionRequest.Name))
+
+	pod, err := j.getTargetPod(ctx, evictionRequest)
+	if apierrors.IsNotFound(err) {
+		logger.Info("Target pod no longer exists, nothing to run")
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	template, failurePolicy, err := j.getJobConfig(ctx, evictionRequest)
+	if err != nil {
+		return err
+	}
+
+	var expectedFinishTime time.Time
+	blocked := false
+	return wait.PollUntilContextCancel(ctx, j.pollInterval, true, func(ctx context.Context) (bool, error) {
+		job, err := j.ensureJob(ctx, evictionRequest, pod, template)
+		if err != nil {
+			return false, err
+		}
+		// Publishing the expected finish time sends a heartbeat, so it is only published when it changes
+		if deadline := job.Spec.ActiveDeadlineSeconds; deadline != nil {
+			if finishTime := job.CreationTimestamp.Add(time.Duration(*deadline) * time.Second); !finishTime.Equal(expectedFinishTime) {
+				expectedFinishTime = finishTime
+				progress.SetExpectedFinishTime(finishTime)
+			}
+		}
+
+		switch {
+		case hasCondition(job, batchv1.JobComplete):
+			logger.Info("Job succeeded", zap.String("job", job.Name))
+			return true, nil
+		case hasCondition(job, batchv1.JobFailed) && failurePolicy == FallThrough:
+			logger.Warn("Job failed, passing on", zap.String("job", job.Name))
+			return true, nil
+		case hasCondition(job, batchv1.JobFailed):
+			if !blocked {
+				logger.Warn("Job failed, blocking the eviction until the Job is deleted", zap.String("job", job.Name))
+			}
+			blocked = true
+		default:
+			blocked = false
+		}
+		return false, nil
+	})
+}
+
+// OnCanceled deletes the Job of the EvictionRequest, along with its pods
+func (j *Interceptor) OnCanceled(ctx context.Context, evictionRequest *v1alpha1.EvictionRequest) error {
+	propagationPolicy := metav1.DeletePropagationBackground
+	err := j.kubeClient.BatchV1().Jobs(evictionRequest.Namespace).Delete(ctx, JobName(evictionRequest), metav1.DeleteOptions{
+		PropagationPolicy: &propagationPolicy,
+	})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// getJobConfig returns the Job template and the FailurePolicy of the active interceptor class of the
+// EvictionRequest. Classes without a .spec.job use JOB_TEMPLATE and JOB_FAILURE_POLICY.
+func (j *Interceptor) getJobConfig(ctx context.Context, evictionRequest *v1alpha1.EvictionRequest) (*batchv1.Job, FailurePolicy, error) {
+	var jobConfig *v1alpha1.JobConfig
+	if className := evictionRequest.Status.ActiveInterceptorClass; className != nil {
+		interceptorClass, err := j.evictionRequestClient.EvictionrequestV1alpha1().InterceptorClasses().Get(ctx, *className, metav1.GetOptions{})
+		if err != nil && !apierrors.IsNotFound(err) {
+			return nil, "", err
+		}
+		if err == nil {
+			jobConfig = interceptorClass.Spec.Job
+		}
+	}
+	if jobConfig == nil {
+		if j.template == nil {
+			return nil, "", fmt.Errorf("the interceptor class has no .spec.job and %s is unset", TemplateEnv)
+		}
+		return j.template, j.failurePolicy, nil
+	}
+
+	ref := jobConfig.TemplateRef
+	configMap, err := j.kubeClient.CoreV1().ConfigMaps(ref.Namespace).Get(ctx, ref.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to get the Job template: %w", err)
+	}
+	source := fmt.Sprintf("%s/%s[%s]", ref.Namespace, ref.Name, ref.Key)
+	data, ok := configMap.Data[ref.Key]
+	if !ok {
+		return nil, "", fmt.Errorf("the Job template %s does not exist", source)
+	}
+	template, err := parseTemplate([]byte(data), source)
+	if err != nil {
+		return nil, "", err
+	}
+	failurePolicy := jobConfig.FailurePolicy
+	if failurePolicy == "" {
+		failurePolicy = FallThrough
+	}
+	return template, failurePolicy, nil
+}
+
+// ensureJob returns the Job of the EvictionRequest, creating it from the template if it does not exist
+func (j *Interceptor) ensureJob(ctx context.Context, evictionRequest *v1alpha1.EvictionRequest, pod *corev1.Pod, template *batchv1.Job) (*batchv1.Job, error) {
+	jobs := j.kubeClient.BatchV1().Jobs(evictionRequest.Namespace)
+	job, err := jobs.Get(ctx, JobName(evictionRequest), metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		job, err = jobs.Create(ctx, newJob(evictionRequest, pod, template), metav1.CreateOptions{})
+		if err == nil {
+			j.logger.Info("Created Job", zap.String("namespace", job.Namespace), zap.String("job", job.Name), zap.String("pod", pod.Name))
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	if !metav1.IsControlledBy(job, evictionRequest) {
+		return nil, fmt.Errorf("job %s/%s is not controlled by the eviction request", job.Namespace, job.Name)
+	}
+	return job, nil
+}
+
+// newJob builds the Job of the EvictionRequest from the template. The Job is owned by the EvictionRequest, so it
+// is garbage collected along with it, and every container receives the details of the target pod.
+func newJob(evictionRequest *v1alpha1.EvictionRequest, pod *corev1.Pod, template *batchv1.Job) *batchv1.Job {
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        JobName(evictionRequest),
+			Namespace:   evictionRequest.Namespace,
+			Labels:      maps.Clone(template.Labels),
+			Annotations: maps.Clone(template.Annotations),
+			OwnerReferences: []metav1.OwnerReference{
+				*metav1.NewControllerRef(evictionRequest, v1alpha1.GroupVersion.WithKind("EvictionRequest")),
+			},
+		},
+		Spec: *template.Spec.DeepCopy(),
+	}
+	if job.Labels == nil {
+		job.Labels = map[string]string{}
+	}
+	job.Labels[LabelEvictionRequestUID] = string(evictionRequest.UID)
+
+	env := []corev1.EnvVar{
+		{Name: "EVICTION_REQUEST_NAME", Value: evictionRequest.Name},
+		{Name: "TARGET_POD_NAME", Value: pod.Name},
+		{Name: "TARGET_POD_NAMESPACE", Value: pod.Namespace},
+		{Name: "TARGET_POD_UID", Value: string(pod.UID)},
+		{Name: "TARGET_POD_IP", Value: pod.Status.PodIP},
+		{Name: "TARGET_NODE_NAME", Value: pod.Spec.NodeName},
+	}
+	podSpec := &job.Spec.Template.Spec
+	for i := range podSpec.InitContainers {
+		podSpec.InitContainers[i].Env = append(podSpec.InitContainers[i].Env, env...)
+	}
+	for i := range podSpec.Containers {
+		podSpec.Containers[i].Env = append(podSpec.Containers[i].Env, env...)
+	}
+	return job
+}
+
+func (j *Interceptor) getTargetPod(ctx context.Context, evictionRequest *v1alpha1.EvictionRequest) (*corev1.Pod, error) {
+	podRef := evictionRequest.Spec.Target.PodRef
+	if podRef == nil {
+		return nil, apierrors.NewNotFound(corev1.Resource("pods"), "")
+	}
+
+	pod, err := j.kubeClient.CoreV1().Pods(evictionRequest.Namespace).Get(ctx, podRef.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	if string(pod.UID) != podRef.UID {
+		return nil, apierrors.NewNotFound(corev1.Resource("pods"), podRef.Name)
+	}
+	return pod, nil
+}
+
+// hasCondition reports whether the Job has a true condition of the given type
+func hasCondition(job *batchv1.Job, conditionType batchv1.JobConditionType) bool {
+	for _, condition := range job.Status.Conditions {
+		if condition.Type == conditionType {
+			return condition.Status == corev1.ConditionTrue
+		}
+	}
+	return false
+}
@@ -0,0 +1,269 @@
+package job
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"code.uber.internal/apis/evictionrequest/v1alpha1"
+	evreqfake "code.uber.internal/pkg/generated/clientset/versioned/fake"
+	"go.uber.org/zap"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/utils/ptr"
+)
+
+const (
+	_interceptorClass = "drain.example.com"
+	_templateKey      = "job.yaml"
+	_template         = `
+metadata:
+  labels:
+    app: drain
+spec:
+  activeDeadlineSeconds: 600
+  template:
+    spec:
+      restartPolicy: Never
+      containers:
+      - name: drain
+        image: drain:latest
+`
+)
+
+// progress records the expected finish time published by the interceptor
+type progress struct {
+	mu                 sync.Mutex
+	expectedFinishTime time.Time
+}
+
+// SetExpectedFinishTime implements interceptorsdk.Progress
+func (p *progress) SetExpectedFinishTime(t time.Time) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.expectedFinishTime = t
+}
+
+func newEvictionRequest() *v1alpha1.EvictionRequest {
+	return &v1alpha1.EvictionRequest{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "pod", UID: "eviction-request-uid"},
+		Spec: v1alpha1.EvictionRequestSpec{
+			Target: v1alpha1.EvictionTarget{PodRef: &v1alpha1.LocalPodReference{Name: "pod", UID: "pod-uid"}},
+		},
+		Status: v1alpha1.EvictionRequestStatus{ActiveInterceptorClass: ptr.To(_interceptorClass)},
+	}
+}
+
+// newTestInterceptor returns an interceptor serving a class whose Job template is in a ConfigMap
+func newTestInterceptor(failurePolicy FailurePolicy) (*Interceptor, kubernetes.Interface) {
+	kubeClient := fake.NewClientset(
+		&corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "pod", UID: "pod-uid"},
+			Spec:       corev1.PodSpec{NodeName: "node"},
+			Status:     corev1.PodStatus{PodIP: "10.0.0.1"},
+		},
+		&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "job-interceptor", Name: "drain"},
+			Data:       map[string]string{_templateKey: _template},
+		},
+	)
+	evictionRequestClient := evreqfake.NewSimpleClientset(&v1alpha1.InterceptorClass{
+		ObjectMeta: metav1.ObjectMeta{Name: _interceptorClass},
+		Spec: v1alpha1.InterceptorClassSpec{
+			Domain: "example.com",
+			Job: &v1alpha1.JobConfig{
+				TemplateRef:   v1alpha1.ConfigMapKeyReference{Namespace: "job-interceptor", Name: "drain", Key: _templateKey},
+				FailurePolicy: failurePolicy,
+			},
+		},
+	})
+	return &Interceptor{
+		kubeClient:            kubeClient,
+		evictionRequestClient: evictionRequestClient,
+		logger:                zap.NewNop(),
+		pollInterval:          time.Millisecond,
+	}, kubeClient
+}
+
+// onAssigned calls OnAssigned in the background and returns the channel receiving its result
+func onAssigned(ctx context.Context, interceptor *Interceptor, evictionRequest *v1alpha1.EvictionRequest) <-chan error {
+	result := make(chan error, 1)
+	go func() {
+		result <- interceptor.OnAssigned(ctx, evictionRequest, &progress{})
+	}()
+	return result
+}
+
+// waitForJob waits until the Job of the eviction request exists and satisfies the condition
+func waitForJob(t *testing.T, kubeClient kubernetes.Interface, condition func(job *batchv1.Job) bool) *batchv1.Job {
+	t.Helper()
+
+	var job *batchv1.Job
+	err := wait.PollUntilContextTimeout(context.Background(), time.Millisecond, 5*time.Second, true, func(ctx context.Context) (bool, error) {
+		var err error
+		job, err = kubeClient.BatchV1().Jobs("default").Get(ctx, JobName(newEvictionRequest()), metav1.GetOptions{})
+		if apierrors.IsNotFound(err) {
+			return false, nil
+		}
+		return err == nil && condition(job), err
+	})
+	if err != nil {
+		t.Fatalf("waiting for the Job: %v", err)
+	}
+	return job
+}
+
+// finishJob sets a true condition of the given type on the Job, like the Job controller does
+func finishJob(t *testing.T, kubeClient kubernetes.Interface, job *batchv1.Job, conditionType batchv1.JobConditionType) {
+	t.Helper()
+
+	job = job.DeepCopy()
+	job.Status.Conditions = append(job.Status.Conditions, batchv1.JobCondition{Type: conditionType, Status: corev1.ConditionTrue})
+	if _, err := kubeClient.BatchV1().Jobs(job.Namespace).UpdateStatus(context.Background(), job, metav1.UpdateOptions{}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func expectResult(t *testing.T, result <-chan error) {
+	t.Helper()
+
+	select {
+	case err := <-result:
+		if err != nil {
+			t.Errorf("OnAssigned() error = %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("OnAssigned() did not return")
+	}
+}
+
+func TestOnAssignedCompletes(t *testing.T) {
+	for _, tc := range []struct {
+		name          string
+		failurePolicy FailurePolicy
+		conditionType batchv1.JobConditionType
+	}{
+		{name: "succeeded", failurePolicy: Block, conditionType: batchv1.JobComplete},
+		{name: "failed with FallThrough", failurePolicy: FallThrough, conditionType: batchv1.JobFailed},
+		{name: "failed with the default policy", conditionType: batchv1.JobFailed},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			interceptor, kubeClient := newTestInterceptor(tc.failurePolicy)
+
+			result := onAssigned(ctx, interceptor, newEvictionRequest())
+			job := waitForJob(t, kubeClient, func(*batchv1.Job) bool { return true })
+			finishJob(t, kubeClient, job, tc.conditionType)
+			expectResult(t, result)
+		})
+	}
+}
+
+func TestOnAssignedBlocks(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	interceptor, kubeClient := newTestInterceptor(Block)
+
+	result := onAssigned(ctx, interceptor, newEvictionRequest())
+	job := waitForJob(t, kubeClient, func(*batchv1.Job) bool { return true })
+	finishJob(t, kubeClient, job, batchv1.JobFailed)
+	select {
+	case err := <-result:
+		t.Fatalf("OnAssigned() = %v, expected it to block on the failed Job", err)
+	case <-time.After(100 * interceptor.pollInterval):
+	}
+
+	// Deleting the failed Job runs it again
+	if err := kubeClient.BatchV1().Jobs("default").Delete(ctx, job.Name, metav1.DeleteOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	job = waitForJob(t, kubeClient, func(job *batchv1.Job) bool { return len(job.Status.Conditions) == 0 })
+	finishJob(t, kubeClient, job, batchv1.JobComplete)
+	expectResult(t, result)
+}
+
+func TestOnAssignedCreatesJobFromTemplate(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		class    string
+		template *batchv1.Job
+		image    string
+	}{
+		{name: "template of the class", class: _interceptorClass, image: "drain:latest"},
+		{
+			name:  "JOB_TEMPLATE",
+			class: "other.example.com",
+			template: &batchv1.Job{Spec: batchv1.JobSpec{Template: corev1.PodTemplateSpec{Spec: corev1.PodSpec{
+				Containers: []corev1.Container{{Name: "default", Image: "default:latest"}},
+			}}}},
+			image: "default:latest",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			interceptor, kubeClient := newTestInterceptor(FallThrough)
+			interceptor.template = tc.template
+			interceptor.failurePolicy = FallThrough
+			evictionRequest := newEvictionRequest()
+			evictionRequest.Status.ActiveInterceptorClass = ptr.To(tc.class)
+
+			result := onAssigned(ctx, interceptor, evictionRequest)
+			job := waitForJob(t, kubeClient, func(*batchv1.Job) bool { return true })
+			if !metav1.IsControlledBy(job, evictionRequest) {
+				t.Errorf("Job owner references = %v, expected the eviction request", job.OwnerReferences)
+			}
+			if uid := job.Labels[LabelEvictionRequestUID]; uid != string(evictionRequest.UID) {
+				t.Errorf("Job label %s = %q, expected %q", LabelEvictionRequestUID, uid, evictionRequest.UID)
+			}
+			container := job.Spec.Template.Spec.Containers[0]
+			if container.Image != tc.image {
+				t.Errorf("Job image = %q, expected %q", container.Image, tc.image)
+			}
+			env := map[string]string{}
+			for _, envVar := range container.Env {
+				env[envVar.Name] = envVar.Value
+			}
+			if env["TARGET_POD_IP"] != "10.0.0.1" || env["TARGET_NODE_NAME"] != "node" {
+				t.Errorf("Job env = %v, expected the details of the target pod", env)
+			}
+			finishJob(t, kubeClient, job, batchv1.JobComplete)
+			expectResult(t, result)
+		})
+	}
+}
+
+func TestOnAssignedWithoutTemplate(t *testing.T) {
+	interceptor, _ := newTestInterceptor(FallThrough)
+	evictionRequest := newEvictionRequest()
+	evictionRequest.Status.ActiveInterceptorClass = ptr.To("other.example.com")
+
+	if err := interceptor.OnAssigned(context.Background(), evictionRequest, &progress{}); err == nil {
+		t.Error("OnAssigned() succeeded, expected an error for a class without a Job template")
+	}
+}
+
+func TestNewJobLabelsLongNames(t *testing.T) {
+	evictionRequest := newEvictionRequest()
+	evictionRequest.Name = strings.Repeat("a", 253)
+	template, err := parseTemplate([]byte(_template), "test")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	job := newJob(evictionRequest, &corev1.Pod{}, template)
+	for key, value := range job.Labels {
+		if errs := validation.IsValidLabelValue(value); len(errs) != 0 {
+			t.Errorf("Job label %s = %q is invalid: %v", key, value, errs)
+		}
+	}
+}
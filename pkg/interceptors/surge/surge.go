@@ -0,0 +1,265 @@
+// Package surge implements a reference interceptor for the "surge before evict" pattern.
+//
+// When an EvictionRequest is assigned to it, the interceptor scales the Deployment (or bare ReplicaSet)
+// owning the target pod up by one replica and heartbeats until a replacement pod is Ready. It then
+// marks itself as completed so that the eviction request controller can evict the target pod. Once the
+// target pod is gone, the workload is scaled back to its original size.
+package surge
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"code.uber.internal/apis/evictionrequest/v1alpha1"
+	"code.uber.internal/pkg/interceptorsdk"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes"
+)
+
+const (
+	// DefaultInterceptorClass is the interceptor class served by the surge interceptor unless configured otherwise
+	DefaultInterceptorClass = "surge.evictionrequest.coordination.uber.com"
+
+	// AnnotationSurgePrefix prefixes the workload annotations recording a surge, keyed by pod UID with the pod
+	// name as value. Each annotation accounts for one extra replica.
+	AnnotationSurgePrefix = "surge.evictionrequest.coordination.uber.com/"
+	// LabelSurging marks workloads that carry surge annotations so they can be found after a restart
+	LabelSurging = "evictionrequest.coordination.uber.com/surging"
+
+	_pollInterval            = 5 * time.Second
+	_restoreInterval         = time.Minute
+	_defaultProgressDeadline = 600 * time.Second
+)
+
+var _ interceptorsdk.Interceptor = &Interceptor{}
+
+// Interceptor scales up the workload of a pod and waits for a replacement to become Ready
+type Interceptor struct {
+	kubeClient kubernetes.Interface
+	logger     *zap.Logger
+}
+
+type params struct {
+	fx.In
+
+	KubeClient kubernetes.Interface
+	Logger     *zap.Logger
+}
+
+// New creates a new surge Interceptor
+func New(params params) *Interceptor {
+	return &Interceptor{
+		kubeClient: params.KubeClient,
+		logger:     params.Logger,
+	}
+}
+
+// OnAssigned scales up the workload owning the target pod and waits until a replacement pod is Ready
+func (s *Interceptor) OnAssigned(ctx context.Context, evictionRequest *v1alpha1.EvictionRequest, progress interceptorsdk.Progress) error {
+	logger := s.logger.With(zap.String("namespace", evictionRequest.Namespace), zap.String("name", evictionRequest.Name))
+
+	pod, err := s.getTargetPod(ctx, evictionRequest)
+	if apierrors.IsNotFound(err) {
+		logger.Info("Target pod no longer exists, nothing to replace")
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	w, err := s.resolveWorkload(ctx, pod)
+	if errors.Is(err, errNoWorkload) {
+		logger.Info("Target pod is not managed by a ReplicaSet, passing on", zap.String("pod", pod.Name))
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	logger = logger.With(zap.Stringer("workload", w))
+
+	var progressDeadline time.Duration
+	err = s.updateWorkload(ctx, w, func(state *workloadState) bool {
+		progressDeadline = _defaultProgressDeadline
+		if state.progressDeadlineSeconds != nil {
+			progressDeadline = time.Duration(*state.progressDeadlineSeconds) * time.Second
+		}
+
+		key := AnnotationSurgePrefix + string(pod.UID)
+		if _, ok := state.annotations[key]; ok {
+			return false
+		}
+		state.annotations[key] = pod.Name
+		state.labels[LabelSurging] = "true"
+		state.replicas++
+		return true
+	})
+	if err != nil {
+		return fmt.Errorf("failed to scale up %s: %w", w, err)
+	}
+
+	logger.Info("Scaled up workload, waiting for a replacement pod to become ready")
+	progress.SetExpectedFinishTime(time.Now().Add(progressDeadline))
+
+	err = wait.PollUntilContextCancel(ctx, _pollInterval, true, func(ctx context.Context) (bool, error) {
+		return s.replacementReady(ctx, w, pod)
+	})
+	if err != nil {
+		return err
+	}
+
+	logger.Info("Replacement pod is ready")
+	return nil
+}
+
+// OnCanceled scales the workload back down if it was scaled up for the target pod
+func (s *Interceptor) OnCanceled(ctx context.Context, evictionRequest *v1alpha1.EvictionRequest) error {
+	pod, err := s.getTargetPod(ctx, evictionRequest)
+	if apierrors.IsNotFound(err) {
+		// The surge is reverted by the restore loop once the pod is gone
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	w, err := s.resolveWorkload(ctx, pod)
+	if errors.Is(err, errNoWorkload) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	s.logger.Info("Eviction request canceled, scaling workload back down",
+		zap.String("namespace", evictionRequest.Namespace),
+		zap.String("name", evictionRequest.Name),
+		zap.Stringer("workload", w))
+	return s.unsurge(ctx, w, string(pod.UID))
+}
+
+// RunRestoreLoop periodically scales workloads back down once the pods they were scaled up for are gone
+func (s *Interceptor) RunRestoreLoop(ctx context.Context) {
+	wait.UntilWithContext(ctx, func(ctx context.Context) {
+		if err := s.restore(ctx); err != nil {
+			s.logger.Error("Failed to restore surged workloads", zap.Error(err))
+		}
+	}, _restoreInterval)
+}
+
+// restore reverts the surges of all workloads whose target pods no longer exist
+func (s *Interceptor) restore(ctx context.Context) error {
+	workloads, err := s.listSurgingWorkloads(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, w := range workloads {
+		state, err := s.getWorkload(ctx, w)
+		if err != nil {
+			s.logger.Warn("Failed to get surged workload", zap.Stringer("workload", w), zap.Error(err))
+			continue
+		}
+
+		for uid, podName := range state.surgedPods() {
+			pod, err := s.kubeClient.CoreV1().Pods(w.namespace).Get(ctx, podName, metav1.GetOptions{})
+			if err != nil && !apierrors.IsNotFound(err) {
+				s.logger.Warn("Failed to get surged pod", zap.Stringer("workload", w), zap.String("pod", podName), zap.Error(err))
+				continue
+			}
+			if err == nil && string(pod.UID) == uid {
+				continue
+			}
+
+			s.logger.Info("Surged pod is gone, scaling workload back down", zap.Stringer("workload", w), zap.String("pod", podName))
+			if err := s.unsurge(ctx, w, uid); err != nil {
+				s.logger.Error("Failed to scale workload back down", zap.Stringer("workload", w), zap.Error(err))
+			}
+		}
+	}
+	return nil
+}
+
+// unsurge removes the surge annotation of the pod and scales the workload down by one replica
+func (s *Interceptor) unsurge(ctx context.Context, w workload, podUID string) error {
+	return s.updateWorkload(ctx, w, func(state *workloadState) bool {
+		key := AnnotationSurgePrefix + podUID
+		if _, ok := state.annotations[key]; !ok {
+			return false
+		}
+		delete(state.annotations, key)
+		if len(state.surgedPods()) == 0 {
+			delete(state.labels, LabelSurging)
+		}
+		if state.replicas > 0 {
+			state.replicas--
+		}
+		return true
+	})
+}
+
+// replacementReady reports whether enough pods other than the target are Ready to serve the workload
+// at its size before any surge
+func (s *Interceptor) replacementReady(ctx context.Context, w workload, target *corev1.Pod) (bool, error) {
+	state, err := s.getWorkload(ctx, w)
+	if err != nil {
+		return false, err
+	}
+
+	selector, err := metav1.LabelSelectorAsSelector(state.selector)
+	if err != nil {
+		return false, fmt.Errorf("invalid selector of %s: %w", w, err)
+	}
+	if selector.Empty() {
+		selector = labels.Nothing()
+	}
+
+	pods, err := s.kubeClient.CoreV1().Pods(w.namespace).List(ctx, metav1.ListOptions{LabelSelector: selector.String()})
+	if err != nil {
+		return false, fmt.Errorf("failed to list pods of %s: %w", w, err)
+	}
+
+	ready := int32(0)
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		if pod.UID != target.UID && pod.DeletionTimestamp == nil && isPodReady(pod) {
+			ready++
+		}
+	}
+
+	desired := state.replicas - int32(len(state.surgedPods()))
+	return ready >= desired, nil
+}
+
+// getTargetPod returns the pod referenced by the EvictionRequest, or a NotFound error if it was replaced
+func (s *Interceptor) getTargetPod(ctx context.Context, evictionRequest *v1alpha1.EvictionRequest) (*corev1.Pod, error) {
+	podRef := evictionRequest.Spec.Target.PodRef
+	if podRef == nil {
+		return nil, apierrors.NewNotFound(corev1.Resource("pods"), "")
+	}
+
+	pod, err := s.kubeClient.CoreV1().Pods(evictionRequest.Namespace).Get(ctx, podRef.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	if string(pod.UID) != podRef.UID {
+		return nil, apierrors.NewNotFound(corev1.Resource("pods"), podRef.Name)
+	}
+	return pod, nil
+}
+
+func isPodReady(pod *corev1.Pod) bool {
+	for _, condition := range pod.Status.Conditions {
+		if condition.Type == corev1.PodReady {
+			return condition.Status == corev1.ConditionTrue
+		}
+	}
+	return false
+}
@@ -0,0 +1,176 @@
+package surge
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/util/retry"
+)
+
+const (
+	_kindDeployment = "Deployment"
+	_kindReplicaSet = "ReplicaSet"
+)
+
+// errNoWorkload is returned when a pod is not controlled by a ReplicaSet
+var errNoWorkload = errors.New("pod is not controlled by a ReplicaSet")
+
+// workload identifies the Deployment or bare ReplicaSet that is scaled to replace a pod
+type workload struct {
+	kind      string
+	namespace string
+	name      string
+}
+
+// workloadState is the part of a workload the interceptor reads and mutates
+type workloadState struct {
+	annotations map[string]string
+	labels      map[string]string
+	replicas    int32
+	selector    *metav1.LabelSelector
+	// progressDeadlineSeconds is how long the workload may take to make progress, if known
+	progressDeadlineSeconds *int32
+}
+
+func (w workload) String() string {
+	return fmt.Sprintf("%s %s/%s", w.kind, w.namespace, w.name)
+}
+
+// resolveWorkload returns the Deployment owning the ReplicaSet of the pod, or the ReplicaSet itself if it
+// is not owned by a Deployment
+func (s *Interceptor) resolveWorkload(ctx context.Context, pod *corev1.Pod) (workload, error) {
+	podOwner := metav1.GetControllerOf(pod)
+	if podOwner == nil || podOwner.Kind != _kindReplicaSet {
+		return workload{}, errNoWorkload
+	}
+
+	replicaSet, err := s.kubeClient.AppsV1().ReplicaSets(pod.Namespace).Get(ctx, podOwner.Name, metav1.GetOptions{})
+	if err != nil {
+		return workload{}, fmt.Errorf("failed to get replica set: %w", err)
+	}
+
+	if owner := metav1.GetControllerOf(replicaSet); owner != nil && owner.Kind == _kindDeployment {
+		return workload{kind: _kindDeployment, namespace: pod.Namespace, name: owner.Name}, nil
+	}
+	return workload{kind: _kindReplicaSet, namespace: pod.Namespace, name: replicaSet.Name}, nil
+}
+
+// getWorkload reads the current state of the workload
+func (s *Interceptor) getWorkload(ctx context.Context, w workload) (*workloadState, error) {
+	var state *workloadState
+	err := s.updateWorkload(ctx, w, func(current *workloadState) bool {
+		state = current
+		return false
+	})
+	return state, err
+}
+
+// updateWorkload applies mutate to the latest version of the workload, retrying on conflicts. The workload
+// is only written if mutate returns true.
+func (s *Interceptor) updateWorkload(ctx context.Context, w workload, mutate func(state *workloadState) bool) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		switch w.kind {
+		case _kindDeployment:
+			deployment, err := s.kubeClient.AppsV1().Deployments(w.namespace).Get(ctx, w.name, metav1.GetOptions{})
+			if err != nil {
+				return err
+			}
+			state := deploymentState(deployment)
+			if !mutate(state) {
+				return nil
+			}
+			deployment.Annotations, deployment.Labels, deployment.Spec.Replicas = state.annotations, state.labels, &state.replicas
+			_, err = s.kubeClient.AppsV1().Deployments(w.namespace).Update(ctx, deployment, metav1.UpdateOptions{})
+			return err
+		case _kindReplicaSet:
+			replicaSet, err := s.kubeClient.AppsV1().ReplicaSets(w.namespace).Get(ctx, w.name, metav1.GetOptions{})
+			if err != nil {
+				return err
+			}
+			state := replicaSetState(replicaSet)
+			if !mutate(state) {
+				return nil
+			}
+			replicaSet.Annotations, replicaSet.Labels, replicaSet.Spec.Replicas = state.annotations, state.labels, &state.replicas
+			_, err = s.kubeClient.AppsV1().ReplicaSets(w.namespace).Update(ctx, replicaSet, metav1.UpdateOptions{})
+			return err
+		default:
+			return fmt.Errorf("unsupported workload kind %q", w.kind)
+		}
+	})
+}
+
+// listSurgingWorkloads returns all workloads that currently carry surge annotations
+func (s *Interceptor) listSurgingWorkloads(ctx context.Context) ([]workload, error) {
+	listOptions := metav1.ListOptions{LabelSelector: labels.SelectorFromSet(labels.Set{LabelSurging: "true"}).String()}
+
+	deployments, err := s.kubeClient.AppsV1().Deployments(metav1.NamespaceAll).List(ctx, listOptions)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list deployments: %w", err)
+	}
+	replicaSets, err := s.kubeClient.AppsV1().ReplicaSets(metav1.NamespaceAll).List(ctx, listOptions)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list replica sets: %w", err)
+	}
+
+	workloads := make([]workload, 0, len(deployments.Items)+len(replicaSets.Items))
+	for _, deployment := range deployments.Items {
+		workloads = append(workloads, workload{kind: _kindDeployment, namespace: deployment.Namespace, name: deployment.Name})
+	}
+	for _, replicaSet := range replicaSets.Items {
+		workloads = append(workloads, workload{kind: _kindReplicaSet, namespace: replicaSet.Namespace, name: replicaSet.Name})
+	}
+	return workloads, nil
+}
+
+func deploymentState(deployment *appsv1.Deployment) *workloadState {
+	return &workloadState{
+		annotations:             copyMap(deployment.Annotations),
+		labels:                  copyMap(deployment.Labels),
+		replicas:                replicasOrDefault(deployment.Spec.Replicas),
+		selector:                deployment.Spec.Selector,
+		progressDeadlineSeconds: deployment.Spec.ProgressDeadlineSeconds,
+	}
+}
+
+func replicaSetState(replicaSet *appsv1.ReplicaSet) *workloadState {
+	return &workloadState{
+		annotations: copyMap(replicaSet.Annotations),
+		labels:      copyMap(replicaSet.Labels),
+		replicas:    replicasOrDefault(replicaSet.Spec.Replicas),
+		selector:    replicaSet.Spec.Selector,
+	}
+}
+
+// surgedPods returns the UIDs and names of the pods the workload was scaled up for
+func (w *workloadState) surgedPods() map[string]string {
+	pods := make(map[string]string)
+	for key, value := range w.annotations {
+		if uid, ok := strings.CutPrefix(key, AnnotationSurgePrefix); ok {
+			pods[uid] = value
+		}
+	}
+	return pods
+}
+
+// replicasOrDefault returns the replicas of a workload, which default to 1 when unset
+func replicasOrDefault(replicas *int32) int32 {
+	if replicas == nil {
+		return 1
+	}
+	return *replicas
+}
+
+func copyMap(in map[string]string) map[string]string {
+	out := make(map[string]string, len(in))
+	for key, value := range in {
+		out[key] = value
+	}
+	return out
+}
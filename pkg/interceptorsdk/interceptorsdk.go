@@ -0,0 +1,241 @@
+// Package interceptorsdk implements the interceptor side of the EvictionRequest protocol.
+//
+// An interceptor author implements the Interceptor interface and hands it to a Runner. The Runner
+// watches EvictionRequests whose .status.activeInterceptorClass is the configured class, adopts
+// them by sending heartbeats through .status.heartbeatTime, publishes the expected finish time
+// reported through Progress and sets .status.activeInterceptorCompleted once the interceptor is done.
+// These fields are written with server-side apply, so the interceptor owns them apart from the fields of the
+// eviction request controller.
+// With Options.LeaseClient, the periodic heartbeats renew a coordination.k8s.io Lease per EvictionRequest instead,
+// and the status is only written on adoption, expected finish time changes and completion.
+// If the class is registered as an InterceptorClass, the Runner also keeps its liveness heartbeat fresh.
+package interceptorsdk
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"code.uber.internal/apis/evictionrequest/v1alpha1"
+	"code.uber.internal/pkg/constants"
+	"code.uber.internal/pkg/generated/clientset/versioned"
+	evreqinformers "code.uber.internal/pkg/generated/informers/externalversions"
+	"go.uber.org/zap"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+)
+
+// Interceptor is implemented by interceptor authors to respond to EvictionRequests assigned to their class.
+type Interceptor interface {
+	// OnAssigned is called in its own goroutine when an EvictionRequest becomes assigned to the
+	// interceptor class. Heartbeats are sent while it runs and the interceptor is marked as completed
+	// once it returns nil. An error is logged and OnAssigned is called again after a backoff.
+	// The context is canceled when the EvictionRequest is canceled, reassigned or the Runner stops.
+	OnAssigned(ctx context.Context, evictionRequest *v1alpha1.EvictionRequest, progress Progress) error
+
+	// OnCanceled is called when an EvictionRequest stops being assigned to the interceptor class
+	// before OnAssigned returned, e.g. because all requesters were removed or it was deleted.
+	// Interceptors should undo any partial work here.
+	OnCanceled(ctx context.Context, evictionRequest *v1alpha1.EvictionRequest) error
+}
+
+// Progress lets an interceptor report on an EvictionRequest it is working on.
+type Progress interface {
+	// SetExpectedFinishTime publishes the time at which the interceptor expects to finish through
+	// .status.expectedInterceptorFinishTime.
+	SetExpectedFinishTime(t time.Time)
+}
+
+// Options configures a Runner
+type Options struct {
+	// InterceptorClass is the class the interceptor serves. This field is required.
+	InterceptorClass string
+	// Namespace restricts the Runner to a single namespace. All namespaces are watched if empty.
+	Namespace string
+	// HeartbeatInterval is the interval between heartbeats. If zero, a quarter of the
+	// .spec.heartbeatDeadlineSeconds of each EvictionRequest is used.
+	HeartbeatInterval time.Duration
+	// Logger is used for logging. A no-op logger is used if nil.
+	Logger *zap.Logger
+	// FieldManager owns the status fields applied by the Runner. The interceptor class is used if empty.
+	FieldManager string
+	// LeaseClient enables Lease heartbeats: the periodic heartbeats renew a Lease per EvictionRequest instead
+	// of writing .status.heartbeatTime. The eviction request controller must run with LEASE_HEARTBEATS enabled,
+	// as it otherwise misses these heartbeats and times the interceptor out.
+	LeaseClient kubernetes.Interface
+}
+
+// Runner drives an Interceptor through the EvictionRequest protocol
+type Runner struct {
+	client      versioned.Interface
+	interceptor Interceptor
+	options     Options
+	logger      *zap.Logger
+
+	mu    sync.Mutex
+	tasks map[types.UID]*task
+	wg    sync.WaitGroup
+	ctx   context.Context
+}
+
+// ErrMissingInterceptorClass is returned by New when Options.InterceptorClass is empty
+var ErrMissingInterceptorClass = errors.New("interceptor class is required")
+
+// New creates a Runner for the given Interceptor
+func New(client versioned.Interface, interceptor Interceptor, options Options) (*Runner, error) {
+	if options.InterceptorClass == "" {
+		return nil, ErrMissingInterceptorClass
+	}
+
+	logger := options.Logger
+	if logger == nil {
+		logger = zap.NewNop()
+	}
+
+	return &Runner{
+		client:      client,
+		interceptor: interceptor,
+		options:     options,
+		logger:      logger.With(zap.String("interceptor_class", options.InterceptorClass)),
+		tasks:       make(map[types.UID]*task),
+	}, nil
+}
+
+// applyOptions returns the options of the status applies of the Runner. The apply is forced: the fields it
+// carries belong to the interceptor, even if the eviction request controller reset them.
+func (r *Runner) applyOptions() metav1.ApplyOptions {
+	fieldManager := r.options.FieldManager
+	if fieldManager == "" {
+		fieldManager = r.options.InterceptorClass
+	}
+	return metav1.ApplyOptions{FieldManager: fieldManager, Force: true}
+}
+
+// Run watches EvictionRequests and processes the ones assigned to the interceptor class until ctx is canceled
+func (r *Runner) Run(ctx context.Context) error {
+	r.ctx = ctx
+
+	factory := evreqinformers.NewSharedInformerFactoryWithOptions(r.client, constants.DefaultResyncInterval,
+		evreqinformers.WithNamespace(r.options.Namespace),
+	)
+	informer := factory.Evictionrequest().V1alpha1().EvictionRequests().Informer()
+	if _, err := informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    r.handle,
+		UpdateFunc: func(_, newObj interface{}) { r.handle(newObj) },
+		DeleteFunc: r.handleDelete,
+	}); err != nil {
+		return err
+	}
+
+	r.logger.Info("Starting interceptor runner")
+	r.wg.Add(1)
+	go func() {
+		defer r.wg.Done()
+		r.reportLiveness(ctx)
+	}()
+
+	factory.Start(ctx.Done())
+	for informerType, synced := range factory.WaitForCacheSync(ctx.Done()) {
+		if !synced {
+			r.logger.Warn("Failed to sync informer cache", zap.String("informer_type", informerType.Name()))
+		}
+	}
+
+	<-ctx.Done()
+	r.logger.Info("Stopping interceptor runner")
+	factory.Shutdown()
+	r.wg.Wait()
+	return nil
+}
+
+// handle starts a task for EvictionRequests assigned to the interceptor class and cancels the
+// tasks of EvictionRequests that are no longer assigned
+func (r *Runner) handle(obj interface{}) {
+	evictionRequest, ok := obj.(*v1alpha1.EvictionRequest)
+	if !ok {
+		return
+	}
+
+	if IsAssigned(evictionRequest, r.options.InterceptorClass) {
+		r.startTask(evictionRequest)
+		return
+	}
+	r.stopTask(evictionRequest)
+}
+
+// handleDelete cancels the task of a deleted EvictionRequest
+func (r *Runner) handleDelete(obj interface{}) {
+	if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+	evictionRequest, ok := obj.(*v1alpha1.EvictionRequest)
+	if !ok {
+		return
+	}
+	r.stopTask(evictionRequest)
+}
+
+// startTask starts processing an assigned EvictionRequest unless it is already being processed.
+// Tasks stay registered after they finish until the informer observes that the EvictionRequest is
+// no longer assigned, so a stale cache cannot start the same work twice.
+func (r *Runner) startTask(evictionRequest *v1alpha1.EvictionRequest) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.tasks[evictionRequest.UID]; ok {
+		return
+	}
+
+	t := newTask(r, evictionRequest.DeepCopy())
+	r.tasks[evictionRequest.UID] = t
+	r.wg.Add(1)
+	go func() {
+		defer r.wg.Done()
+		t.run()
+	}()
+}
+
+// stopTask cancels the task of an EvictionRequest that is no longer assigned. OnCanceled is called
+// unless the interceptor already finished its work.
+func (r *Runner) stopTask(evictionRequest *v1alpha1.EvictionRequest) {
+	r.mu.Lock()
+	t, ok := r.tasks[evictionRequest.UID]
+	if ok {
+		delete(r.tasks, evictionRequest.UID)
+	}
+	r.mu.Unlock()
+
+	if !ok || !t.cancel() {
+		return
+	}
+
+	r.logger.Info("Eviction request is no longer assigned, canceling",
+		zap.String("namespace", evictionRequest.Namespace),
+		zap.String("name", evictionRequest.Name))
+
+	r.wg.Add(1)
+	go func() {
+		defer r.wg.Done()
+		if err := r.interceptor.OnCanceled(r.ctx, evictionRequest.DeepCopy()); err != nil {
+			r.logger.Error("Interceptor failed to handle cancellation",
+				zap.String("namespace", evictionRequest.Namespace),
+				zap.String("name", evictionRequest.Name),
+				zap.Error(err))
+		}
+	}()
+}
+
+// IsAssigned reports whether the EvictionRequest is waiting on the given interceptor class
+func IsAssigned(evictionRequest *v1alpha1.EvictionRequest, interceptorClass string) bool {
+	status := evictionRequest.Status
+	return evictionRequest.DeletionTimestamp == nil &&
+		len(evictionRequest.Spec.Requesters) > 0 &&
+		status.ActiveInterceptorClass != nil &&
+		*status.ActiveInterceptorClass == interceptorClass &&
+		!status.ActiveInterceptorCompleted &&
+		!meta.IsStatusConditionTrue(status.Conditions, string(v1alpha1.EvictionRequestComplete))
+}
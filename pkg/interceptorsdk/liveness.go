@@ -0,0 +1,53 @@
+package interceptorsdk
+
+import (
+	"context"
+	"time"
+
+	evreqapply "code.uber.internal/pkg/generated/applyconfiguration/evictionrequest/v1alpha1"
+	"code.uber.internal/pkg/interceptorclass"
+	"go.uber.org/zap"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// _livenessHeartbeatsPerDeadline is the number of liveness heartbeats sent per liveness deadline
+const _livenessHeartbeatsPerDeadline = 3
+
+// _unregisteredClassInterval is how often the Runner checks whether an unregistered class was registered
+const _unregisteredClassInterval = time.Minute
+
+// reportLiveness refreshes .status.heartbeatTime of the InterceptorClass until ctx is canceled, so the
+// eviction request controller keeps selecting the class. Nothing is reported while the class is not registered.
+func (r *Runner) reportLiveness(ctx context.Context) {
+	for {
+		interval, err := r.livenessHeartbeat(ctx)
+		if err != nil && ctx.Err() == nil {
+			r.logger.Warn("Failed to report interceptor class liveness", zap.Error(err))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(interval):
+		}
+	}
+}
+
+// livenessHeartbeat updates the heartbeat of the InterceptorClass and returns the delay until the next one
+func (r *Runner) livenessHeartbeat(ctx context.Context) (time.Duration, error) {
+	client := r.client.EvictionrequestV1alpha1().InterceptorClasses()
+
+	class, err := client.Get(ctx, r.options.InterceptorClass, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return _unregisteredClassInterval, nil
+	}
+	if err != nil {
+		return _unregisteredClassInterval, err
+	}
+
+	interval := interceptorclass.LivenessDeadline(class) / _livenessHeartbeatsPerDeadline
+	_, err = client.ApplyStatus(ctx, evreqapply.InterceptorClass(class.Name).
+		WithStatus(evreqapply.InterceptorClassStatus().WithHeartbeatTime(metav1.Now())), r.applyOptions())
+	return interval, err
+}
@@ -0,0 +1,265 @@
+package interceptorsdk
+
+import (
+	"context"
+	"errors"
+	"math"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"code.uber.internal/apis/evictionrequest/v1alpha1"
+	evreqapply "code.uber.internal/pkg/generated/applyconfiguration/evictionrequest/v1alpha1"
+	"code.uber.internal/pkg/heartbeat"
+	"go.uber.org/zap"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	coordinationv1apply "k8s.io/client-go/applyconfigurations/coordination/v1"
+	metav1apply "k8s.io/client-go/applyconfigurations/meta/v1"
+	"k8s.io/client-go/util/retry"
+)
+
+const (
+	// _defaultHeartbeatDeadlineSeconds mirrors the CRD default of .spec.heartbeatDeadlineSeconds
+	_defaultHeartbeatDeadlineSeconds = 1800
+	// _heartbeatsPerDeadline is the number of heartbeats sent within one heartbeat deadline
+	_heartbeatsPerDeadline = 4
+)
+
+// errNotAssigned is returned by status updates once the EvictionRequest is no longer assigned to the interceptor class
+var errNotAssigned = errors.New("eviction request is no longer assigned to the interceptor class")
+
+// task processes a single EvictionRequest assigned to the interceptor class
+type task struct {
+	runner          *Runner
+	evictionRequest *v1alpha1.EvictionRequest
+	logger          *zap.Logger
+
+	ctx      context.Context
+	cancelFn context.CancelFunc
+
+	// finished is set once OnAssigned returned nil
+	finished atomic.Bool
+
+	mu                 sync.Mutex
+	expectedFinishTime *time.Time
+	heartbeatNow       chan struct{}
+}
+
+func newTask(runner *Runner, evictionRequest *v1alpha1.EvictionRequest) *task {
+	ctx, cancel := context.WithCancel(runner.ctx)
+	return &task{
+		runner:          runner,
+		evictionRequest: evictionRequest,
+		logger: runner.logger.With(
+			zap.String("namespace", evictionRequest.Namespace),
+			zap.String("name", evictionRequest.Name),
+		),
+		ctx:          ctx,
+		cancelFn:     cancel,
+		heartbeatNow: make(chan struct{}, 1),
+	}
+}
+
+// SetExpectedFinishTime implements Progress and publishes the estimate with an immediate heartbeat
+func (t *task) SetExpectedFinishTime(expectedFinishTime time.Time) {
+	t.mu.Lock()
+	t.expectedFinishTime = &expectedFinishTime
+	t.mu.Unlock()
+
+	select {
+	case t.heartbeatNow <- struct{}{}:
+	default:
+	}
+}
+
+// cancel stops the task and reports whether the interceptor was interrupted before it finished
+func (t *task) cancel() bool {
+	t.cancelFn()
+	return !t.finished.Load()
+}
+
+// run adopts the EvictionRequest, heartbeats while the interceptor works on it and marks the
+// interceptor as completed once it is done
+func (t *task) run() {
+	t.logger.Info("Adopting eviction request")
+	if err := t.heartbeat(); errors.Is(err, errNotAssigned) {
+		return
+	}
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		t.intercept()
+	}()
+
+	ticker := time.NewTicker(t.heartbeatInterval())
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-t.ctx.Done():
+			<-done
+			return
+		case <-done:
+			if t.finished.Load() {
+				t.complete()
+			}
+			return
+		case <-ticker.C:
+			t.renew()
+		case <-t.heartbeatNow:
+			_ = t.heartbeat()
+		}
+	}
+}
+
+// intercept calls OnAssigned until it succeeds or the task is canceled
+func (t *task) intercept() {
+	backoff := newBackoff()
+	for {
+		err := t.runner.interceptor.OnAssigned(t.ctx, t.evictionRequest.DeepCopy(), t)
+		if err == nil {
+			t.finished.Store(true)
+			return
+		}
+		if t.ctx.Err() != nil {
+			return
+		}
+
+		delay := backoff.Step()
+		t.logger.Error("Interceptor failed, retrying", zap.Duration("retry_after", delay), zap.Error(err))
+		select {
+		case <-t.ctx.Done():
+			return
+		case <-time.After(delay):
+		}
+	}
+}
+
+// heartbeat refreshes .status.heartbeatTime and publishes the expected finish time if one was reported
+func (t *task) heartbeat() error {
+	err := t.applyStatus(false)
+	if err != nil && t.ctx.Err() == nil {
+		t.logger.Warn("Failed to send heartbeat", zap.Error(err))
+	}
+	return err
+}
+
+// renew sends a periodic heartbeat: it renews the heartbeat Lease if Lease heartbeats are enabled and refreshes
+// .status.heartbeatTime otherwise
+func (t *task) renew() {
+	if t.runner.options.LeaseClient == nil {
+		_ = t.heartbeat()
+		return
+	}
+	if err := t.renewLease(); err != nil && t.ctx.Err() == nil {
+		t.logger.Warn("Failed to renew heartbeat lease", zap.Error(err))
+	}
+}
+
+// renewLease creates or renews the heartbeat Lease of the EvictionRequest with server-side apply. The Lease is
+// owned by the EvictionRequest, so it is garbage collected along with it.
+func (t *task) renewLease() error {
+	owner := metav1apply.OwnerReference().
+		WithAPIVersion(v1alpha1.SchemeGroupVersion.String()).
+		WithKind("EvictionRequest").
+		WithName(t.evictionRequest.Name).
+		WithUID(t.evictionRequest.UID)
+	lease := coordinationv1apply.Lease(heartbeat.LeaseName(t.evictionRequest), t.evictionRequest.Namespace).
+		WithLabels(map[string]string{heartbeat.LeaseLabel: "true"}).
+		WithOwnerReferences(owner).
+		WithSpec(coordinationv1apply.LeaseSpec().
+			WithHolderIdentity(t.runner.options.InterceptorClass).
+			WithLeaseDurationSeconds(t.heartbeatDeadlineSeconds()).
+			WithRenewTime(metav1.NowMicro()))
+	_, err := t.runner.options.LeaseClient.CoordinationV1().Leases(t.evictionRequest.Namespace).Apply(t.ctx, lease, t.runner.applyOptions())
+	return err
+}
+
+// complete sets .status.activeInterceptorCompleted, retrying until it is persisted, the EvictionRequest
+// is no longer assigned or the task is canceled
+func (t *task) complete() {
+	backoff := newBackoff()
+	for {
+		err := t.applyStatus(true)
+		if err == nil {
+			t.logger.Info("Interceptor completed")
+			return
+		}
+		if errors.Is(err, errNotAssigned) || t.ctx.Err() != nil {
+			return
+		}
+
+		delay := backoff.Step()
+		t.logger.Error("Failed to mark interceptor as completed, retrying", zap.Duration("retry_after", delay), zap.Error(err))
+		select {
+		case <-t.ctx.Done():
+			return
+		case <-time.After(delay):
+		}
+	}
+}
+
+// applyStatus applies the status fields owned by the interceptor with server-side apply, retrying on conflicts:
+// the heartbeat, the expected finish time if one was reported and the completion. Every apply carries all of
+// them, as the fields left out of an apply are removed. The apply is only made while the EvictionRequest is
+// still assigned to the interceptor class.
+func (t *task) applyStatus(completed bool) error {
+	t.mu.Lock()
+	expectedFinishTime := t.expectedFinishTime
+	t.mu.Unlock()
+
+	client := t.runner.client.EvictionrequestV1alpha1().EvictionRequests(t.evictionRequest.Namespace)
+	return retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		current, err := client.Get(t.ctx, t.evictionRequest.Name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		if current.UID != t.evictionRequest.UID || !IsAssigned(current, t.runner.options.InterceptorClass) {
+			return errNotAssigned
+		}
+
+		status := evreqapply.EvictionRequestStatus().WithHeartbeatTime(metav1.Now())
+		if expectedFinishTime != nil {
+			status.WithExpectedInterceptorFinishTime(metav1.NewTime(*expectedFinishTime))
+		}
+		if completed {
+			status.WithActiveInterceptorCompleted(true)
+		}
+		// The resource version fails the apply with a conflict if the EvictionRequest changed since it was checked
+		evictionRequest := evreqapply.EvictionRequest(current.Name, current.Namespace).
+			WithResourceVersion(current.ResourceVersion).
+			WithStatus(status)
+		_, err = client.ApplyStatus(t.ctx, evictionRequest, t.runner.applyOptions())
+		return err
+	})
+}
+
+// heartbeatInterval returns the configured interval, or a fraction of the heartbeat deadline of the EvictionRequest
+func (t *task) heartbeatInterval() time.Duration {
+	if t.runner.options.HeartbeatInterval > 0 {
+		return t.runner.options.HeartbeatInterval
+	}
+
+	return time.Duration(t.heartbeatDeadlineSeconds()) * time.Second / _heartbeatsPerDeadline
+}
+
+// heartbeatDeadlineSeconds returns the .spec.heartbeatDeadlineSeconds of the EvictionRequest or its default
+func (t *task) heartbeatDeadlineSeconds() int32 {
+	if t.evictionRequest.Spec.HeartbeatDeadlineSeconds != nil && *t.evictionRequest.Spec.HeartbeatDeadlineSeconds > 0 {
+		return *t.evictionRequest.Spec.HeartbeatDeadlineSeconds
+	}
+	return _defaultHeartbeatDeadlineSeconds
+}
+
+// newBackoff returns the backoff used between retries of the interceptor and of status updates
+func newBackoff() *wait.Backoff {
+	return &wait.Backoff{
+		Duration: time.Second,
+		Factor:   2,
+		Jitter:   0.1,
+		Steps:    math.MaxInt32,
+		Cap:      5 * time.Minute,
+	}
+}
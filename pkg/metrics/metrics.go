@@ -0,0 +1,83 @@
+// Package metrics serves the expvar metrics of the eviction request controller.
+package metrics
+
+import (
+	"context"
+	"errors"
+	"expvar"
+	"net/http"
+	"os"
+	"time"
+
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+)
+
+const (
+	// AddrEnv is the environment variable holding the address of the metrics server (e.g. :8080).
+	// The metrics server is disabled if it is not set.
+	AddrEnv = "METRICS_ADDR"
+
+	// Path is the path serving the metrics as JSON
+	Path = "/debug/vars"
+
+	_readHeaderTimeout = 10 * time.Second
+)
+
+type Interface interface {
+	Start()
+}
+
+type server struct {
+	lc     fx.Lifecycle
+	logger *zap.Logger
+	addr   string
+}
+
+type params struct {
+	fx.In
+
+	Lifecycle fx.Lifecycle
+	Logger    *zap.Logger
+}
+
+// New creates the metrics server from METRICS_ADDR
+func New(params params) Interface {
+	return &server{
+		lc:     params.Lifecycle,
+		logger: params.Logger,
+		addr:   os.Getenv(AddrEnv),
+	}
+}
+
+// Start registers the fx lifecycle hooks serving the metrics
+func (s *server) Start() {
+	if s.addr == "" {
+		s.logger.Info("Metrics server disabled", zap.String("env", AddrEnv))
+		return
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle(Path, expvar.Handler())
+	httpServer := &http.Server{
+		Addr:              s.addr,
+		Handler:           mux,
+		ReadHeaderTimeout: _readHeaderTimeout,
+	}
+
+	s.lc.Append(fx.Hook{
+		OnStart: func(ctx context.Context) error {
+			go func() {
+				s.logger.Info("Starting metrics server", zap.String("addr", s.addr))
+				if err := httpServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+					s.logger.Error("Metrics server failed", zap.Error(err))
+				}
+			}()
+			return nil
+		},
+		OnStop: func(ctx context.Context) error {
+			s.logger.Info("Stopping metrics server")
+			return httpServer.Shutdown(ctx)
+		},
+	})
+}
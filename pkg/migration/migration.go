@@ -0,0 +1,110 @@
+// Package migration rewrites eviction requests in the storage version of the CRD, so that older versions can be
+// dropped from its status.storedVersions, like the kube-storage-version-migrator does.
+package migration
+
+import (
+	"context"
+	"expvar"
+
+	"code.uber.internal/apis/evictionrequest/v1alpha1"
+	"code.uber.internal/pkg/config"
+	"code.uber.internal/pkg/generated/clientset/versioned"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// _pageSize is the number of eviction requests listed per request
+const _pageSize = 500
+
+var (
+	// _migrated counts the eviction requests rewritten in the storage version
+	_migrated = expvar.NewInt("evictionrequest_storage_migrated_total")
+	// _migrationErrors counts the eviction requests that failed to be rewritten
+	_migrationErrors = expvar.NewInt("evictionrequest_storage_migration_errors_total")
+)
+
+type Interface interface {
+	// Run rewrites every eviction request in the storage version once. It does nothing unless the migration is
+	// enabled.
+	Run(ctx context.Context)
+}
+
+type migrator struct {
+	evictionRequestClient versioned.Interface
+	options               config.Options
+	logger                *zap.Logger
+}
+
+type params struct {
+	fx.In
+
+	EvictionRequestClient versioned.Interface
+	Options               config.Options
+	Logger                *zap.Logger
+}
+
+// New creates the storage version migrator of eviction requests
+func New(params params) Interface {
+	return &migrator{
+		evictionRequestClient: params.EvictionRequestClient,
+		options:               params.Options,
+		logger:                params.Logger,
+	}
+}
+
+// Run rewrites every eviction request with an unchanged update. The API server encodes updated objects in the
+// storage version, and skips the write of objects that are already stored in it.
+func (m *migrator) Run(ctx context.Context) {
+	if !m.options.MigrateStorageVersion {
+		return
+	}
+	m.logger.Info("Migrating eviction requests to the storage version")
+
+	migrated, failed := 0, 0
+	options := metav1.ListOptions{Limit: _pageSize}
+	for {
+		list, err := m.evictionRequestClient.EvictionrequestV1alpha1().EvictionRequests(metav1.NamespaceAll).List(ctx, options)
+		if err != nil {
+			m.logger.Error("Failed to list eviction requests, storage version migration aborted", zap.Error(err))
+			return
+		}
+		for i := range list.Items {
+			if err := m.migrate(ctx, &list.Items[i]); err != nil {
+				failed++
+				_migrationErrors.Add(1)
+				m.logger.Error("Failed to migrate eviction request",
+					zap.String("name", list.Items[i].Name),
+					zap.String("namespace", list.Items[i].Namespace),
+					zap.Error(err))
+				continue
+			}
+			migrated++
+			_migrated.Add(1)
+		}
+		if list.Continue == "" {
+			break
+		}
+		options.Continue = list.Continue
+	}
+
+	if failed > 0 {
+		m.logger.Error("Storage version migration incomplete, keep the stored versions of the CRD",
+			zap.Int("migrated", migrated), zap.Int("failed", failed))
+		return
+	}
+	m.logger.Info("Storage version migration complete, versions other than the storage version can be removed "+
+		"from the status.storedVersions of the CRD", zap.Int("migrated", migrated))
+}
+
+// migrate rewrites an eviction request. Conflicts and deletions are fine: the concurrent write stored the
+// eviction request in the storage version already.
+func (m *migrator) migrate(ctx context.Context, evictionRequest *v1alpha1.EvictionRequest) error {
+	_, err := m.evictionRequestClient.EvictionrequestV1alpha1().EvictionRequests(evictionRequest.Namespace).
+		Update(ctx, evictionRequest, metav1.UpdateOptions{})
+	if apierrors.IsConflict(err) || apierrors.IsNotFound(err) {
+		return nil
+	}
+	return err
+}
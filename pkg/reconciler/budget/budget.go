@@ -0,0 +1,372 @@
+// Package budget limits how fast and how many pods are evicted through the Eviction API.
+//
+// Budgets are scoped globally, per namespace, per node and per topology zone. Each scope can have a
+// token bucket rate limit and a maximum number of concurrent evictions, i.e. evicted pods that still exist.
+// Eviction requests waiting on the same scope are admitted in the order in which they started waiting.
+package budget
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	"code.uber.internal/apis/evictionrequest/v1alpha1"
+	"code.uber.internal/pkg/constants"
+	evreqlisters "code.uber.internal/pkg/generated/listers/evictionrequest/v1alpha1"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+	"golang.org/x/time/rate"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/informers"
+	corev1listers "k8s.io/client-go/listers/core/v1"
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// ConfigEnv is the environment variable holding the path of the budget configuration file
+	ConfigEnv = "EVICTION_BUDGET_CONFIG"
+
+	// ReasonRateLimited means that the rate limit of a scope is exhausted
+	ReasonRateLimited = "RateLimited"
+	// ReasonConcurrencyLimited means that the maximum number of concurrent evictions of a scope is reached
+	ReasonConcurrencyLimited = "ConcurrencyLimited"
+
+	// _concurrencyRetryInterval is how often a request waiting for a concurrent eviction slot checks again
+	_concurrencyRetryInterval = 10 * time.Second
+	// _waiterExpiry is how long a waiting request keeps its place without checking again, e.g. after it was deleted
+	_waiterExpiry = 3 * _concurrencyRetryInterval
+)
+
+// Limits are the budget of a single scope. Zero values are unlimited.
+type Limits struct {
+	// QPS is the number of evictions per second
+	QPS float64 `json:"qps,omitempty"`
+	// Burst is the number of evictions that can happen at once. Defaults to 1 if QPS is set.
+	Burst int `json:"burst,omitempty"`
+	// MaxConcurrent is the number of evicted pods that may exist at the same time
+	MaxConcurrent int `json:"maxConcurrent,omitempty"`
+}
+
+// Config is the content of the budget configuration file. Each scope is applied separately to every
+// namespace, node and zone.
+type Config struct {
+	Global       *Limits `json:"global,omitempty"`
+	PerNamespace *Limits `json:"perNamespace,omitempty"`
+	PerNode      *Limits `json:"perNode,omitempty"`
+	PerZone      *Limits `json:"perZone,omitempty"`
+}
+
+// Decision is the outcome of Acquire
+type Decision struct {
+	// Allowed is true if the pod may be evicted now
+	Allowed bool
+	// Scope is the exhausted scope, e.g. namespace/default
+	Scope string
+	// Reason is RateLimited or ConcurrencyLimited
+	Reason string
+	// Message describes the exhausted budget
+	Message string
+	// RetryAfter is when the request should try again
+	RetryAfter time.Duration
+}
+
+type Interface interface {
+	// Acquire takes the budget to evict the pod targeted by the eviction request, or reports why it has to wait
+	Acquire(evictionRequest *v1alpha1.EvictionRequest, pod *corev1.Pod) Decision
+	// Release returns the concurrent eviction slot of a pod whose eviction failed
+	Release(pod *corev1.Pod)
+}
+
+type scope struct {
+	key    string
+	limits Limits
+}
+
+type inFlightEviction struct {
+	namespace string
+	name      string
+	scopes    []string
+}
+
+type waiter struct {
+	since    time.Time
+	lastSeen time.Time
+	scopes   []string
+}
+
+type budget struct {
+	config Config
+	logger *zap.Logger
+	clock  clock.Clock
+
+	podLister             corev1listers.PodLister
+	nodeLister            corev1listers.NodeLister
+	evictionRequestLister evreqlisters.EvictionRequestLister
+
+	mu       sync.Mutex
+	seeded   bool
+	limiters map[string]*rate.Limiter
+	inFlight map[types.UID]inFlightEviction
+	waiting  map[types.UID]waiter
+}
+
+type params struct {
+	fx.In
+
+	PodLister             corev1listers.PodLister
+	EvictionRequestLister evreqlisters.EvictionRequestLister
+	KubeInformerFactory   informers.SharedInformerFactory
+	Logger                *zap.Logger
+	Clock                 clock.Clock
+}
+
+// New creates the eviction budgets from the configuration file in EVICTION_BUDGET_CONFIG.
+// Evictions are not limited if the variable is not set.
+func New(params params) (Interface, error) {
+	b := &budget{
+		logger:                params.Logger,
+		clock:                 params.Clock,
+		podLister:             params.PodLister,
+		evictionRequestLister: params.EvictionRequestLister,
+		limiters:              make(map[string]*rate.Limiter),
+		inFlight:              make(map[types.UID]inFlightEviction),
+		waiting:               make(map[types.UID]waiter),
+	}
+
+	path := os.Getenv(ConfigEnv)
+	if path == "" {
+		return b, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read eviction budget config: %w", err)
+	}
+	if err := yaml.UnmarshalStrict(data, &b.config); err != nil {
+		return nil, fmt.Errorf("failed to parse eviction budget config: %w", err)
+	}
+
+	// Nodes are only watched when they are needed to resolve zones
+	if b.config.PerZone != nil {
+		b.nodeLister = params.KubeInformerFactory.Core().V1().Nodes().Lister()
+	}
+
+	params.Logger.Info("Loaded eviction budgets", zap.Any("config", b.config))
+	return b, nil
+}
+
+// Acquire checks the concurrency and rate limits of every scope of the pod. If all have room and no request
+// that waits longer for the same scope is ahead, the budget is taken and the pod counts as in flight until
+// it is gone. A pod that is already in flight holds its budget, so it is allowed again without taking more.
+func (b *budget) Acquire(evictionRequest *v1alpha1.EvictionRequest, pod *corev1.Pod) Decision {
+	scopes := b.scopes(pod)
+	if len(scopes) == 0 {
+		return Decision{Allowed: true}
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	now := b.clock.Now()
+	b.seed()
+	b.prune(now)
+
+	if _, ok := b.inFlight[pod.UID]; ok {
+		delete(b.waiting, evictionRequest.UID)
+		return Decision{Allowed: true}
+	}
+
+	since := now
+	if w, ok := b.waiting[evictionRequest.UID]; ok {
+		since = w.since
+	}
+
+	for _, s := range scopes {
+		if s.limits.MaxConcurrent <= 0 {
+			continue
+		}
+		inFlight := b.countInFlight(s.key)
+		if inFlight+b.waitersAhead(evictionRequest.UID, since, s.key) >= s.limits.MaxConcurrent {
+			return b.wait(evictionRequest.UID, since, now, scopes, Decision{
+				Scope:      s.key,
+				Reason:     ReasonConcurrencyLimited,
+				Message:    fmt.Sprintf("Waiting for one of %d concurrent evictions in %s", s.limits.MaxConcurrent, s.key),
+				RetryAfter: _concurrencyRetryInterval,
+			})
+		}
+	}
+
+	for _, s := range scopes {
+		if s.limits.QPS <= 0 {
+			continue
+		}
+		needed := float64(b.waitersAhead(evictionRequest.UID, since, s.key) + 1)
+		if tokens := b.limiter(s).TokensAt(now); tokens < needed {
+			return b.wait(evictionRequest.UID, since, now, scopes, Decision{
+				Scope:      s.key,
+				Reason:     ReasonRateLimited,
+				Message:    fmt.Sprintf("Waiting for the rate limit of %g evictions per second in %s", s.limits.QPS, s.key),
+				RetryAfter: time.Duration((needed - tokens) / s.limits.QPS * float64(time.Second)),
+			})
+		}
+	}
+
+	keys := make([]string, 0, len(scopes))
+	for _, s := range scopes {
+		if s.limits.QPS > 0 {
+			b.limiter(s).AllowN(now, 1)
+		}
+		if s.limits.MaxConcurrent > 0 {
+			keys = append(keys, s.key)
+		}
+	}
+	b.inFlight[pod.UID] = inFlightEviction{namespace: pod.Namespace, name: pod.Name, scopes: keys}
+	delete(b.waiting, evictionRequest.UID)
+	return Decision{Allowed: true}
+}
+
+// Release frees the concurrent eviction slot of the pod
+func (b *budget) Release(pod *corev1.Pod) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	delete(b.inFlight, pod.UID)
+}
+
+// scopes returns the configured scopes that apply to the pod
+func (b *budget) scopes(pod *corev1.Pod) []scope {
+	var scopes []scope
+	if b.config.Global != nil {
+		scopes = append(scopes, scope{key: "global", limits: *b.config.Global})
+	}
+	if b.config.PerNamespace != nil {
+		scopes = append(scopes, scope{key: "namespace/" + pod.Namespace, limits: *b.config.PerNamespace})
+	}
+	if b.config.PerNode != nil && pod.Spec.NodeName != "" {
+		scopes = append(scopes, scope{key: "node/" + pod.Spec.NodeName, limits: *b.config.PerNode})
+	}
+	if b.config.PerZone != nil && pod.Spec.NodeName != "" {
+		node, err := b.nodeLister.Get(pod.Spec.NodeName)
+		if err != nil {
+			b.logger.Warn("Failed to get node of pod, skipping zone budget", zap.String("node", pod.Spec.NodeName), zap.Error(err))
+		} else if zone := node.Labels[corev1.LabelTopologyZone]; zone != "" {
+			scopes = append(scopes, scope{key: "zone/" + zone, limits: *b.config.PerZone})
+		}
+	}
+	return scopes
+}
+
+// limiter returns the token bucket of the scope
+func (b *budget) limiter(s scope) *rate.Limiter {
+	limiter, ok := b.limiters[s.key]
+	if !ok {
+		burst := s.limits.Burst
+		if burst <= 0 {
+			burst = 1
+		}
+		limiter = rate.NewLimiter(rate.Limit(s.limits.QPS), burst)
+		b.limiters[s.key] = limiter
+	}
+	return limiter
+}
+
+// wait records the request as waiting so it keeps its place and returns the decision
+func (b *budget) wait(uid types.UID, since, now time.Time, scopes []scope, decision Decision) Decision {
+	keys := make([]string, 0, len(scopes))
+	for _, s := range scopes {
+		keys = append(keys, s.key)
+	}
+	b.waiting[uid] = waiter{since: since, lastSeen: now, scopes: keys}
+	return decision
+}
+
+// waitersAhead counts the requests that wait longer than the given one for the scope
+func (b *budget) waitersAhead(uid types.UID, since time.Time, key string) int {
+	ahead := 0
+	for otherUID, w := range b.waiting {
+		if otherUID == uid || !contains(w.scopes, key) {
+			continue
+		}
+		if w.since.Before(since) || (w.since.Equal(since) && otherUID < uid) {
+			ahead++
+		}
+	}
+	return ahead
+}
+
+// countInFlight counts the evicted pods of the scope that still exist
+func (b *budget) countInFlight(key string) int {
+	count := 0
+	for _, eviction := range b.inFlight {
+		if contains(eviction.scopes, key) {
+			count++
+		}
+	}
+	return count
+}
+
+// prune forgets evicted pods that are gone and requests that stopped waiting
+func (b *budget) prune(now time.Time) {
+	for uid, eviction := range b.inFlight {
+		pod, err := b.podLister.Pods(eviction.namespace).Get(eviction.name)
+		if err != nil || pod.UID != uid {
+			delete(b.inFlight, uid)
+		}
+	}
+	for uid, w := range b.waiting {
+		if now.Sub(w.lastSeen) > _waiterExpiry {
+			delete(b.waiting, uid)
+		}
+	}
+}
+
+// seed restores the in flight evictions from the eviction requests after a restart
+func (b *budget) seed() {
+	if b.seeded {
+		return
+	}
+	b.seeded = true
+
+	evictionRequests, err := b.evictionRequestLister.List(labels.Everything())
+	if err != nil {
+		b.logger.Warn("Failed to list eviction requests, in flight evictions are not restored", zap.Error(err))
+		return
+	}
+	sort.Slice(evictionRequests, func(i, j int) bool {
+		return evictionRequests[i].CreationTimestamp.Before(&evictionRequests[j].CreationTimestamp)
+	})
+
+	for _, evictionRequest := range evictionRequests {
+		podRef := evictionRequest.Spec.Target.PodRef
+		if podRef == nil || !meta.IsStatusConditionTrue(evictionRequest.Status.Conditions, constants.ConditionTypeEvicted) {
+			continue
+		}
+		pod, err := b.podLister.Pods(evictionRequest.Namespace).Get(podRef.Name)
+		if err != nil || string(pod.UID) != podRef.UID {
+			continue
+		}
+
+		var keys []string
+		for _, s := range b.scopes(pod) {
+			if s.limits.MaxConcurrent > 0 {
+				keys = append(keys, s.key)
+			}
+		}
+		b.inFlight[pod.UID] = inFlightEviction{namespace: pod.Namespace, name: pod.Name, scopes: keys}
+	}
+	b.logger.Info("Restored in flight evictions", zap.Int("count", len(b.inFlight)))
+}
+
+func contains(values []string, value string) bool {
+	for _, candidate := range values {
+		if candidate == value {
+			return true
+		}
+	}
+	return false
+}
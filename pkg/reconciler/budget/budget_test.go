@@ -0,0 +1,113 @@
+package budget
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"code.uber.internal/apis/evictionrequest/v1alpha1"
+	evreqlisters "code.uber.internal/pkg/generated/listers/evictionrequest/v1alpha1"
+	"go.uber.org/zap"
+	"golang.org/x/time/rate"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	corev1listers "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+	clocktesting "k8s.io/utils/clock/testing"
+)
+
+// newTestBudget returns a budget with the given config whose pod lister holds the pods
+func newTestBudget(t *testing.T, config Config, pods ...*corev1.Pod) (*budget, *clocktesting.FakeClock) {
+	t.Helper()
+
+	podIndexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+	for _, pod := range pods {
+		if err := podIndexer.Add(pod); err != nil {
+			t.Fatal(err)
+		}
+	}
+	fakeClock := clocktesting.NewFakeClock(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+	return &budget{
+		config:                config,
+		logger:                zap.NewNop(),
+		clock:                 fakeClock,
+		podLister:             corev1listers.NewPodLister(podIndexer),
+		evictionRequestLister: evreqlisters.NewEvictionRequestLister(cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})),
+		limiters:              make(map[string]*rate.Limiter),
+		inFlight:              make(map[types.UID]inFlightEviction),
+		waiting:               make(map[types.UID]waiter),
+	}, fakeClock
+}
+
+func newPod(name string) *corev1.Pod {
+	return &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: name, UID: types.UID(name + "-uid")}}
+}
+
+func newEvictionRequest(pod *corev1.Pod) *v1alpha1.EvictionRequest {
+	return &v1alpha1.EvictionRequest{ObjectMeta: metav1.ObjectMeta{Namespace: pod.Namespace, Name: pod.Name, UID: types.UID(pod.Name + "-evreq-uid")}}
+}
+
+func TestAcquireAllowsPodInFlight(t *testing.T) {
+	pod := newPod("pod")
+	b, _ := newTestBudget(t, Config{Global: &Limits{QPS: 1, MaxConcurrent: 1}}, pod)
+	evictionRequest := newEvictionRequest(pod)
+
+	if decision := b.Acquire(evictionRequest, pod); !decision.Allowed {
+		t.Fatalf("first Acquire() = %+v, expected allowed", decision)
+	}
+	// The pod holds the only concurrent slot and the rate limit has no token left
+	if decision := b.Acquire(evictionRequest, pod); !decision.Allowed {
+		t.Errorf("Acquire() of a pod in flight = %+v, expected allowed", decision)
+	}
+	if tokens := b.limiters["global"].TokensAt(b.clock.Now()); tokens != 0 {
+		t.Errorf("Acquire() of a pod in flight took a rate token, %g tokens left", tokens)
+	}
+}
+
+func TestAcquireConcurrencyLimit(t *testing.T) {
+	first, second := newPod("first"), newPod("second")
+	b, _ := newTestBudget(t, Config{PerNamespace: &Limits{MaxConcurrent: 1}}, first, second)
+
+	if decision := b.Acquire(newEvictionRequest(first), first); !decision.Allowed {
+		t.Fatalf("Acquire(first) = %+v, expected allowed", decision)
+	}
+	decision := b.Acquire(newEvictionRequest(second), second)
+	if decision.Allowed || decision.Reason != ReasonConcurrencyLimited || decision.Scope != "namespace/default" {
+		t.Fatalf("Acquire(second) = %+v, expected %s in namespace/default", decision, ReasonConcurrencyLimited)
+	}
+
+	// The slot is free once the first pod is released
+	b.Release(first)
+	if decision := b.Acquire(newEvictionRequest(second), second); !decision.Allowed {
+		t.Errorf("Acquire(second) after release = %+v, expected allowed", decision)
+	}
+}
+
+func TestAcquireRateLimitOrder(t *testing.T) {
+	var pods []*corev1.Pod
+	for i := range 3 {
+		pods = append(pods, newPod(fmt.Sprintf("pod-%d", i)))
+	}
+	b, fakeClock := newTestBudget(t, Config{Global: &Limits{QPS: 1}}, pods...)
+
+	if decision := b.Acquire(newEvictionRequest(pods[0]), pods[0]); !decision.Allowed {
+		t.Fatalf("Acquire(pod-0) = %+v, expected allowed", decision)
+	}
+	// pod-1 starts waiting before pod-2, so it is admitted first
+	if decision := b.Acquire(newEvictionRequest(pods[1]), pods[1]); decision.Allowed || decision.Reason != ReasonRateLimited {
+		t.Fatalf("Acquire(pod-1) = %+v, expected %s", decision, ReasonRateLimited)
+	}
+	fakeClock.Step(100 * time.Millisecond)
+	if decision := b.Acquire(newEvictionRequest(pods[2]), pods[2]); decision.Allowed || decision.Reason != ReasonRateLimited {
+		t.Fatalf("Acquire(pod-2) = %+v, expected %s", decision, ReasonRateLimited)
+	}
+
+	fakeClock.Step(time.Second)
+	if decision := b.Acquire(newEvictionRequest(pods[2]), pods[2]); decision.Allowed {
+		t.Errorf("Acquire(pod-2) = %+v, expected to wait behind pod-1", decision)
+	}
+	if decision := b.Acquire(newEvictionRequest(pods[1]), pods[1]); !decision.Allowed {
+		t.Errorf("Acquire(pod-1) = %+v, expected allowed", decision)
+	}
+}
@@ -8,14 +8,20 @@ import (
 	"code.uber.internal/apis/evictionrequest/v1alpha1"
 	"code.uber.internal/pkg/constants"
 	"code.uber.internal/pkg/generated/clientset/versioned"
+	"code.uber.internal/pkg/reconciler/budget"
+	"code.uber.internal/pkg/reconciler/requeue"
 	"code.uber.internal/pkg/reconciler/status"
+	"code.uber.internal/pkg/reconciler/window"
 	"go.uber.org/fx"
 	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
 	policyv1 "k8s.io/api/policy/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
 	v1 "k8s.io/client-go/listers/core/v1"
+	"k8s.io/utils/clock"
 )
 
 type Interface interface {
@@ -27,7 +33,10 @@ type evictionPerformer struct {
 	EvictionRequestClient versioned.Interface
 	KubeClient            kubernetes.Interface
 	StatusHandler         status.Interface
+	Budget                budget.Interface
+	Window                window.Interface
 	Logger                *zap.Logger
+	Clock                 clock.Clock
 }
 
 func New(params params) Interface {
@@ -36,7 +45,10 @@ func New(params params) Interface {
 		EvictionRequestClient: params.EvictionRequestClient,
 		KubeClient:            params.KubeClient,
 		StatusHandler:         params.StatusHandler,
+		Budget:                params.Budget,
+		Window:                params.Window,
 		Logger:                params.Logger,
+		Clock:                 params.Clock,
 	}
 }
 
@@ -47,7 +59,10 @@ type params struct {
 	EvictionRequestClient versioned.Interface
 	KubeClient            kubernetes.Interface
 	StatusHandler         status.Interface
+	Budget                budget.Interface
+	Window                window.Interface
 	Logger                *zap.Logger
+	Clock                 clock.Clock
 }
 
 // Perform executes the pod eviction logic for an eviction request
@@ -68,6 +83,19 @@ func (e *evictionPerformer) Perform(ctx context.Context, evictionRequest *v1alph
 		return fmt.Errorf("failed to get pod: %w", err)
 	}
 
+	// An evicted or terminating pod is not evicted again, the eviction request completes once the pod is gone
+	if meta.IsStatusConditionTrue(evictionRequest.Status.Conditions, constants.ConditionTypeEvicted) || pod.DeletionTimestamp != nil {
+		e.Logger.Debug("Pod is already evicted or terminating, skipping", zap.String("target_pod_name", pod.Name))
+		return nil
+	}
+
+	if err := e.Window.Wait(ctx, evictionRequest, pod); err != nil {
+		return err
+	}
+	if err := e.acquireBudget(ctx, evictionRequest, pod); err != nil {
+		return err
+	}
+
 	// Create eviction object
 	eviction := &policyv1.Eviction{
 		ObjectMeta: metav1.ObjectMeta{
@@ -80,6 +108,7 @@ func (e *evictionPerformer) Perform(ctx context.Context, evictionRequest *v1alph
 	// Perform eviction using Kubernetes clientset
 	if err := e.KubeClient.CoreV1().Pods(pod.Namespace).EvictV1(ctx, eviction); err != nil {
 		e.Logger.Error("Failed to evict pod", zap.Error(err))
+		e.Budget.Release(pod)
 		e.StatusHandler.IncrementFailedEvictionCounter(ctx, evictionRequest)
 		return fmt.Errorf("failed to evict pod: %w", err)
 	}
@@ -89,3 +118,37 @@ func (e *evictionPerformer) Perform(ctx context.Context, evictionRequest *v1alph
 
 	return nil
 }
+
+// acquireBudget takes the eviction budget for the pod. While the budget is exhausted, the WaitingForEvictionBudget
+// condition is set and the eviction request is requeued.
+func (e *evictionPerformer) acquireBudget(ctx context.Context, evictionRequest *v1alpha1.EvictionRequest, pod *corev1.Pod) error {
+	decision := e.Budget.Acquire(evictionRequest, pod)
+	waiting := meta.FindStatusCondition(evictionRequest.Status.Conditions, constants.ConditionTypeWaitingForEvictionBudget)
+
+	if decision.Allowed {
+		// Persisted along with the Evicted condition
+		if waiting != nil && waiting.Status == metav1.ConditionTrue {
+			meta.SetStatusCondition(&evictionRequest.Status.Conditions, metav1.Condition{
+				Type:               constants.ConditionTypeWaitingForEvictionBudget,
+				Status:             metav1.ConditionFalse,
+				LastTransitionTime: metav1.NewTime(e.Clock.Now()),
+				Reason:             constants.ReasonEvictionBudgetAvailable,
+				Message:            "Eviction budget acquired",
+			})
+		}
+		return nil
+	}
+
+	e.Logger.Info("Waiting for eviction budget",
+		zap.String("target_pod_name", pod.Name),
+		zap.String("scope", decision.Scope),
+		zap.String("reason", decision.Reason),
+		zap.Duration("retry_after", decision.RetryAfter))
+
+	if waiting == nil || waiting.Status != metav1.ConditionTrue || waiting.Reason != decision.Reason || waiting.Message != decision.Message {
+		if err := e.StatusHandler.UpsertCondition(ctx, evictionRequest, constants.ConditionTypeWaitingForEvictionBudget, metav1.ConditionTrue, decision.Reason, decision.Message); err != nil {
+			return fmt.Errorf("failed to update eviction budget condition: %w", err)
+		}
+	}
+	return requeue.After(decision.RetryAfter)
+}
@@ -0,0 +1,162 @@
+package eviction_test
+
+import (
+	"testing"
+	"time"
+
+	"code.uber.internal/pkg/constants"
+	"code.uber.internal/pkg/reconciler"
+	"code.uber.internal/pkg/reconciler/budget"
+	"code.uber.internal/pkg/reconciler/eviction"
+	"code.uber.internal/pkg/testutil/harness"
+	"go.uber.org/fx"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// newPerformer starts the eviction performer with the fakes of a harness holding the given objects
+func newPerformer(t *testing.T, objects ...runtime.Object) (*harness.Harness, eviction.Interface) {
+	t.Helper()
+
+	h := harness.New(t, objects...)
+	var performer eviction.Interface
+	h.App(reconciler.Module, fx.Populate(&performer))
+	h.Start()
+	return h, performer
+}
+
+func TestPerformSkipsEvictedOrTerminatingPods(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		terminating bool
+		evicted     bool
+	}{
+		{name: "evicted", evicted: true},
+		{name: "terminating", terminating: true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			pod := harness.NewPod("default", "pod")
+			if tc.terminating {
+				pod.DeletionTimestamp = &metav1.Time{Time: metav1.Now().Time}
+				pod.Finalizers = []string{"example.com/finalizer"}
+			}
+			evictionRequest := harness.NewEvictionRequest(pod, "requester")
+			if tc.evicted {
+				meta.SetStatusCondition(&evictionRequest.Status.Conditions, metav1.Condition{
+					Type:   constants.ConditionTypeEvicted,
+					Status: metav1.ConditionTrue,
+					Reason: constants.ReasonEvictionSucceeded,
+				})
+			}
+			h, performer := newPerformer(t, pod, evictionRequest)
+			// Another eviction of the pod would fail, e.g. on its pod disruption budget
+			h.FailEvictions(errors.NewTooManyRequests("disruption budget exhausted", 10))
+
+			if err := performer.Perform(h.Context(), evictionRequest.DeepCopy()); err != nil {
+				t.Fatalf("Perform() error = %v", err)
+			}
+			got := h.EvictionRequest(evictionRequest.Namespace, evictionRequest.Name)
+			if got.Status.PodEvictionStatus != nil {
+				t.Errorf("Perform() evicted the pod again, status = %+v", got.Status.PodEvictionStatus)
+			}
+		})
+	}
+}
+
+func TestPerform(t *testing.T) {
+	for _, tc := range []struct {
+		name          string
+		evictionError error
+		expectErr     bool
+		evicted       metav1.ConditionStatus
+		reason        string
+		failed        int32
+	}{
+		{name: "success", evicted: metav1.ConditionTrue, reason: constants.ReasonEvictionSucceeded},
+		{
+			name:          "pod disruption budget",
+			evictionError: errors.NewTooManyRequests("Cannot evict pod as it would violate the pod's disruption budget.", 10),
+			expectErr:     true,
+			evicted:       metav1.ConditionFalse,
+			reason:        constants.ReasonEvictionFailed,
+			failed:        1,
+		},
+		{
+			name:          "not found",
+			evictionError: errors.NewNotFound(corev1.Resource("pods"), "pod"),
+			expectErr:     true,
+			evicted:       metav1.ConditionFalse,
+			reason:        constants.ReasonEvictionFailed,
+			failed:        1,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			pod := harness.NewPod("default", "pod")
+			evictionRequest := harness.NewEvictionRequest(pod, "requester")
+			h, performer := newPerformer(t, pod, evictionRequest)
+			h.FailEvictions(tc.evictionError)
+
+			err := performer.Perform(h.Context(), evictionRequest.DeepCopy())
+			if (err != nil) != tc.expectErr {
+				t.Fatalf("Perform() error = %v, expected error %v", err, tc.expectErr)
+			}
+
+			_, getErr := h.KubeClient.CoreV1().Pods(pod.Namespace).Get(h.Context(), pod.Name, metav1.GetOptions{})
+			if evicted := errors.IsNotFound(getErr); evicted != (tc.evictionError == nil) {
+				t.Errorf("pod evicted = %v, expected %v", evicted, tc.evictionError == nil)
+			}
+			got := h.EvictionRequest(evictionRequest.Namespace, evictionRequest.Name)
+			condition := meta.FindStatusCondition(got.Status.Conditions, constants.ConditionTypeEvicted)
+			if condition == nil || condition.Status != tc.evicted || condition.Reason != tc.reason {
+				t.Errorf("Evicted condition = %+v, expected %s with reason %s", condition, tc.evicted, tc.reason)
+			}
+			var failed int32
+			if got.Status.PodEvictionStatus != nil {
+				failed = got.Status.PodEvictionStatus.FailedAPIEvictionCounter
+			}
+			if failed != tc.failed {
+				t.Errorf("failed eviction counter = %d, expected %d", failed, tc.failed)
+			}
+		})
+	}
+}
+
+func TestPerformPodNotFound(t *testing.T) {
+	pod := harness.NewPod("default", "pod")
+	evictionRequest := harness.NewEvictionRequest(pod, "requester")
+	h, performer := newPerformer(t, evictionRequest)
+
+	if err := performer.Perform(h.Context(), evictionRequest.DeepCopy()); err != nil {
+		t.Fatalf("Perform() error = %v", err)
+	}
+	if got := h.EvictionRequest(evictionRequest.Namespace, evictionRequest.Name); len(got.Status.Conditions) != 0 {
+		t.Errorf("conditions = %+v, expected none for a missing pod", got.Status.Conditions)
+	}
+}
+
+func TestPerformStampsConditionsWithClock(t *testing.T) {
+	pod := harness.NewPod("default", "pod")
+	evictionRequest := harness.NewEvictionRequest(pod, "requester")
+	meta.SetStatusCondition(&evictionRequest.Status.Conditions, metav1.Condition{
+		Type:               constants.ConditionTypeWaitingForEvictionBudget,
+		Status:             metav1.ConditionTrue,
+		LastTransitionTime: metav1.NewTime(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)),
+		Reason:             budget.ReasonRateLimited,
+	})
+	h, performer := newPerformer(t, pod, evictionRequest)
+	h.Step(time.Hour)
+
+	if err := performer.Perform(h.Context(), evictionRequest.DeepCopy()); err != nil {
+		t.Fatalf("Perform() error = %v", err)
+	}
+	got := h.EvictionRequest(evictionRequest.Namespace, evictionRequest.Name)
+	for _, conditionType := range []string{constants.ConditionTypeWaitingForEvictionBudget, constants.ConditionTypeEvicted} {
+		condition := meta.FindStatusCondition(got.Status.Conditions, conditionType)
+		if condition == nil || !condition.LastTransitionTime.Time.Equal(h.Clock.Now()) {
+			t.Errorf("%s condition = %+v, expected a transition at %s", conditionType, condition, h.Clock.Now())
+		}
+	}
+}
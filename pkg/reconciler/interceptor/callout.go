@@ -0,0 +1,64 @@
+package interceptor
+
+import (
+	"context"
+
+	"code.uber.internal/apis/evictionrequest/v1alpha1"
+	"code.uber.internal/pkg/callout"
+	"code.uber.internal/pkg/reconciler/requeue"
+	"go.uber.org/zap"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// handleCallout calls the endpoint serving the active interceptor class and translates its response into
+// the heartbeat, expected finish time and completion of the interceptor. Failed calls are returned as errors
+// so the worker retries them with backoff, while the heartbeat deadline keeps applying.
+func (i *interceptorHandler) handleCallout(ctx context.Context, evictionRequest *v1alpha1.EvictionRequest, endpoint callout.Endpoint) error {
+	// Status updates trigger a reconcile, only call the endpoint once per poll interval
+	if heartbeatTime := evictionRequest.Status.HeartbeatTime; heartbeatTime != nil {
+		if wait := endpoint.PollInterval() - i.Clock.Since(heartbeatTime.Time); wait > 0 {
+			return requeue.After(wait)
+		}
+	}
+
+	response, err := i.Callout.Call(ctx, endpoint, evictionRequest)
+	if err != nil {
+		i.Logger.Warn("Callout failed",
+			zap.String("interceptor_class", endpoint.InterceptorClass),
+			zap.Error(err))
+		return err
+	}
+
+	i.Logger.Info("Callout responded",
+		zap.String("interceptor_class", endpoint.InterceptorClass),
+		zap.String("state", string(response.State)))
+
+	now := metav1.NewTime(i.Clock.Now())
+	evictionRequest.Status.HeartbeatTime = &now
+	if response.Message != "" {
+		evictionRequest.Status.Message = response.Message
+	}
+
+	switch response.State {
+	case callout.InProgress:
+		if response.ExpectedFinishTime != nil {
+			evictionRequest.Status.ExpectedInterceptorFinishTime = &metav1.Time{Time: *response.ExpectedFinishTime}
+		}
+		if entry := activeHistoryEntry(&evictionRequest.Status); entry != nil {
+			observeProgress(entry, &evictionRequest.Status)
+		}
+		if err := i.updateEvictionRequestStatus(ctx, evictionRequest); err != nil {
+			return err
+		}
+		return requeue.After(endpoint.PollInterval())
+	case callout.Refuse:
+		// The eviction request is handed over to the next interceptor, but the refusal is not a completion
+		evictionRequest.Status.ActiveInterceptorCompleted = true
+		finishHistoryEntry(&evictionRequest.Status, v1alpha1.InterceptorRefused, now)
+		return i.updateEvictionRequestStatus(ctx, evictionRequest)
+	default:
+		// Done completes the interceptor, which is recorded once the next interceptor is selected
+		evictionRequest.Status.ActiveInterceptorCompleted = true
+		return i.updateEvictionRequestStatus(ctx, evictionRequest)
+	}
+}
@@ -0,0 +1,91 @@
+package interceptor_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"code.uber.internal/apis/evictionrequest/v1alpha1"
+	"code.uber.internal/pkg/callout"
+	"code.uber.internal/pkg/constants"
+	"code.uber.internal/pkg/reconciler"
+	"code.uber.internal/pkg/reconciler/interceptor"
+	"code.uber.internal/pkg/testutil/harness"
+	"go.uber.org/fx"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+const _calloutClass = "callout.example.com"
+
+func TestCalloutRefuseIsNotACompletion(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		state       callout.State
+		reason      v1alpha1.InterceptorCompletionReason
+		expectEvict bool
+	}{
+		{name: "done", state: callout.Done, reason: v1alpha1.InterceptorCompleted, expectEvict: true},
+		{name: "refuse", state: callout.Refuse, reason: v1alpha1.InterceptorRefused, expectEvict: false},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				fmt.Fprintf(w, `{"state": %q}`, tc.state)
+			}))
+			t.Cleanup(server.Close)
+
+			pod := harness.NewPod("default", "pod")
+			evictionRequest := harness.NewEvictionRequest(pod, "requester", v1alpha1.Interceptor{InterceptorClass: _calloutClass})
+			evictionRequest.Status.ActiveInterceptorClass = ptr.To(_calloutClass)
+			evictionRequest.Status.InterceptorHistory = []v1alpha1.InterceptorHistoryEntry{{
+				InterceptorClass: _calloutClass,
+				SelectionTime:    metav1.Now(),
+			}}
+			h := harness.New(t,
+				pod,
+				&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: pod.Namespace}},
+				&v1alpha1.InterceptorClass{
+					ObjectMeta: metav1.ObjectMeta{Name: _calloutClass},
+					Spec: v1alpha1.InterceptorClassSpec{
+						Domain:  "example.com",
+						Callout: &v1alpha1.CalloutConfig{URL: server.URL},
+					},
+				},
+				&v1alpha1.EvictionPolicy{
+					ObjectMeta: metav1.ObjectMeta{Name: "no-fallback"},
+					Spec:       v1alpha1.EvictionPolicySpec{AllowDirectEvictionFallback: ptr.To(false)},
+				},
+				evictionRequest,
+			)
+			var handler interceptor.Interface
+			h.App(reconciler.Module, fx.Populate(&handler))
+			h.Start()
+
+			// The first reconcile calls the endpoint, the second hands over to the next interceptor
+			for range 2 {
+				current := h.EvictionRequest(evictionRequest.Namespace, evictionRequest.Name)
+				if err := handler.Handle(h.Context(), current); err != nil {
+					t.Fatalf("Handle() error = %v", err)
+				}
+			}
+
+			got := h.EvictionRequest(evictionRequest.Namespace, evictionRequest.Name)
+			if reason := got.Status.InterceptorHistory[0].Reason; reason != tc.reason {
+				t.Errorf("history reason = %q, expected %q", reason, tc.reason)
+			}
+			_, err := h.KubeClient.CoreV1().Pods(pod.Namespace).Get(h.Context(), pod.Name, metav1.GetOptions{})
+			if evicted := err != nil; evicted != tc.expectEvict {
+				t.Errorf("pod evicted = %v, expected %v", evicted, tc.expectEvict)
+			}
+			if !tc.expectEvict {
+				condition := meta.FindStatusCondition(got.Status.Conditions, constants.ConditionTypeEvicted)
+				if condition == nil || condition.Reason != constants.ReasonDirectEvictionFallbackNotAllowed {
+					t.Errorf("Evicted condition = %+v, expected reason %s", condition, constants.ReasonDirectEvictionFallbackNotAllowed)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,99 @@
+package interceptor
+
+import (
+	"fmt"
+	"time"
+
+	"code.uber.internal/apis/evictionrequest/v1alpha1"
+	"code.uber.internal/pkg/constants"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// clampFutureHeartbeat resets a heartbeat that is further in the future than the allowed clock skew to now,
+// as it would otherwise postpone the heartbeat deadline. It returns true if the status was changed.
+func (i *interceptorHandler) clampFutureHeartbeat(evictionRequest *v1alpha1.EvictionRequest, now time.Time) bool {
+	heartbeatTime := evictionRequest.Status.HeartbeatTime
+	if heartbeatTime == nil || !heartbeatTime.After(now.Add(i.Options.MaxClockSkew)) {
+		return false
+	}
+
+	message := fmt.Sprintf("Heartbeat of interceptor %s is %s in the future, exceeding the allowed clock skew of %s",
+		*evictionRequest.Status.ActiveInterceptorClass, heartbeatTime.Sub(now).Round(time.Second), i.Options.MaxClockSkew)
+	i.Logger.Warn("Ignoring heartbeat in the future",
+		zap.String("interceptor_class", *evictionRequest.Status.ActiveInterceptorClass),
+		zap.Time("heartbeat_time", heartbeatTime.Time))
+	i.Recorder.Event(evictionRequest, corev1.EventTypeWarning, constants.ReasonFutureHeartbeat, message)
+
+	evictionRequest.Status.HeartbeatTime = &metav1.Time{Time: now}
+	return true
+}
+
+// observeLeaseHeartbeat takes the last renewal of the heartbeat Lease of the active interceptor as its heartbeat
+// if it is more recent than .status.heartbeatTime. The heartbeat is only persisted with the next status update,
+// e.g. when the interceptor adopts the eviction request, so renewals do not cause status writes. Renewals before
+// the selection of the interceptor or beyond the allowed clock skew are ignored.
+func (i *interceptorHandler) observeLeaseHeartbeat(evictionRequest *v1alpha1.EvictionRequest, now time.Time) {
+	renewTime := i.Heartbeat.RenewTime(evictionRequest)
+	if renewTime == nil || renewTime.After(now.Add(i.Options.MaxClockSkew)) {
+		return
+	}
+	if entry := activeHistoryEntry(&evictionRequest.Status); entry != nil && renewTime.Before(&entry.SelectionTime) {
+		return
+	}
+	if heartbeatTime := evictionRequest.Status.HeartbeatTime; heartbeatTime != nil && !renewTime.After(heartbeatTime.Time) {
+		return
+	}
+	evictionRequest.Status.HeartbeatTime = renewTime
+}
+
+// finishTimeDeadline returns the deadline derived from the expected finish time of the active interceptor:
+// its selection time plus the estimated duration multiplied by the configured grace multiplier
+func (i *interceptorHandler) finishTimeDeadline(status *v1alpha1.EvictionRequestStatus) (time.Time, bool) {
+	entry := activeHistoryEntry(status)
+	if i.Options.FinishTimeGraceMultiplier == 0 || status.ExpectedInterceptorFinishTime == nil || entry == nil {
+		return time.Time{}, false
+	}
+
+	estimate := status.ExpectedInterceptorFinishTime.Sub(entry.SelectionTime.Time)
+	return entry.SelectionTime.Add(time.Duration(float64(estimate) * i.Options.FinishTimeGraceMultiplier)), true
+}
+
+// updateOverdueCondition keeps the InterceptorOverdue condition in line with the expected finish time of the
+// active interceptor and emits an event when it becomes overdue. It returns true if the status was changed.
+func (i *interceptorHandler) updateOverdueCondition(evictionRequest *v1alpha1.EvictionRequest, now time.Time) bool {
+	status := &evictionRequest.Status
+	overdue := status.ExpectedInterceptorFinishTime != nil && now.After(status.ExpectedInterceptorFinishTime.Time)
+	flagged := meta.IsStatusConditionTrue(status.Conditions, constants.ConditionTypeInterceptorOverdue)
+
+	switch {
+	case overdue && !flagged:
+		message := fmt.Sprintf("Interceptor %s expected to finish at %s",
+			*status.ActiveInterceptorClass, status.ExpectedInterceptorFinishTime.UTC().Format(time.RFC3339))
+		i.Logger.Warn("Interceptor is overdue",
+			zap.String("interceptor_class", *status.ActiveInterceptorClass),
+			zap.Time("expected_finish_time", status.ExpectedInterceptorFinishTime.Time))
+		i.Recorder.Event(evictionRequest, corev1.EventTypeWarning, constants.ConditionTypeInterceptorOverdue, message)
+		meta.SetStatusCondition(&status.Conditions, metav1.Condition{
+			Type:               constants.ConditionTypeInterceptorOverdue,
+			Status:             metav1.ConditionTrue,
+			LastTransitionTime: metav1.NewTime(now),
+			Reason:             constants.ReasonExpectedFinishTimeExceeded,
+			Message:            message,
+		})
+		return true
+	case !overdue && flagged:
+		meta.SetStatusCondition(&status.Conditions, metav1.Condition{
+			Type:               constants.ConditionTypeInterceptorOverdue,
+			Status:             metav1.ConditionFalse,
+			LastTransitionTime: metav1.NewTime(now),
+			Reason:             constants.ReasonWithinExpectedFinishTime,
+			Message:            fmt.Sprintf("Interceptor %s is within its expected finish time", *status.ActiveInterceptorClass),
+		})
+		return true
+	default:
+		return false
+	}
+}
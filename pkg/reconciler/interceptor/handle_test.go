@@ -0,0 +1,149 @@
+package interceptor
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"code.uber.internal/apis/evictionrequest/v1alpha1"
+	evreqlisters "code.uber.internal/pkg/generated/listers/evictionrequest/v1alpha1"
+	"code.uber.internal/pkg/reconciler/requeue"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	_high = "high.example.com"
+	_low  = "low.example.com"
+)
+
+// selected returns a history entry of an interceptor selected at the given time, completed if reason is set
+func selected(interceptorClass string, at time.Time, reason v1alpha1.InterceptorCompletionReason) v1alpha1.InterceptorHistoryEntry {
+	entry := v1alpha1.InterceptorHistoryEntry{InterceptorClass: interceptorClass, SelectionTime: metav1.NewTime(at)}
+	if reason != "" {
+		completion := metav1.NewTime(_now)
+		entry.CompletionTime = &completion
+		entry.Reason = reason
+	}
+	return entry
+}
+
+func TestHandleStates(t *testing.T) {
+	for _, tc := range []struct {
+		name  string
+		setup func(status *v1alpha1.EvictionRequestStatus)
+		// classes are the registered interceptor classes
+		classes []*v1alpha1.InterceptorClass
+		// active is the expected active interceptor class, empty if the pod is evicted
+		active    string
+		completed bool
+		history   []v1alpha1.InterceptorCompletionReason
+		requeue   bool
+	}{
+		{
+			name:    "no active interceptor selects the highest priority",
+			setup:   func(*v1alpha1.EvictionRequestStatus) {},
+			active:  _high,
+			history: []v1alpha1.InterceptorCompletionReason{""},
+		},
+		{
+			name:    "completed interceptor selects the next",
+			setup:   activate(_high, true, _now.Add(-time.Minute)),
+			active:  _low,
+			history: []v1alpha1.InterceptorCompletionReason{v1alpha1.InterceptorCompleted, ""},
+		},
+		{
+			name: "completed last interceptor evicts the pod",
+			setup: func(status *v1alpha1.EvictionRequestStatus) {
+				status.InterceptorHistory = []v1alpha1.InterceptorHistoryEntry{selected(_high, _now.Add(-time.Hour), v1alpha1.InterceptorCompleted)}
+				activate(_low, true, _now.Add(-time.Minute))(status)
+			},
+			completed: true,
+			history:   []v1alpha1.InterceptorCompletionReason{v1alpha1.InterceptorCompleted, v1alpha1.InterceptorCompleted},
+		},
+		{
+			name:    "interceptor class without a live implementation is skipped",
+			setup:   func(*v1alpha1.EvictionRequestStatus) {},
+			classes: []*v1alpha1.InterceptorClass{{ObjectMeta: metav1.ObjectMeta{Name: _high}}},
+			active:  _low,
+			history: []v1alpha1.InterceptorCompletionReason{v1alpha1.InterceptorNotLive, ""},
+		},
+		{
+			name: "interceptor within its heartbeat deadline is waited for",
+			setup: func(status *v1alpha1.EvictionRequestStatus) {
+				activate(_high, false, _now.Add(-time.Hour))(status)
+				heartbeat := metav1.NewTime(_now.Add(-time.Minute))
+				status.HeartbeatTime = &heartbeat
+				status.InterceptorHistory[0].FirstHeartbeatTime = &heartbeat
+			},
+			active:  _high,
+			history: []v1alpha1.InterceptorCompletionReason{""},
+			requeue: true,
+		},
+		{
+			name:      "interceptor past its heartbeat deadline is completed",
+			setup:     activate(_high, false, _now.Add(-time.Hour)),
+			active:    _high,
+			completed: true,
+			history:   []v1alpha1.InterceptorCompletionReason{v1alpha1.InterceptorDeadlineExceeded},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			evictionRequest := newEvictionRequest(
+				v1alpha1.Interceptor{InterceptorClass: _low, Priority: 100},
+				v1alpha1.Interceptor{InterceptorClass: _high, Priority: 200},
+			)
+			tc.setup(&evictionRequest.Status)
+			handler, performer := newTestHandler(t, evictionRequest)
+			classes := newIndexer()
+			for _, class := range tc.classes {
+				if err := classes.Add(class); err != nil {
+					t.Fatal(err)
+				}
+			}
+			handler.InterceptorClassLister = evreqlisters.NewInterceptorClassLister(classes)
+
+			err := handler.Handle(context.Background(), evictionRequest)
+			if after, ok := requeue.Is(err); ok != tc.requeue || (ok && after <= 0) {
+				t.Fatalf("Handle() error = %v, expected requeue %v", err, tc.requeue)
+			}
+			if !tc.requeue && err != nil {
+				t.Fatalf("Handle() error = %v", err)
+			}
+
+			got := getEvictionRequest(t, handler, evictionRequest)
+			if tc.active == "" {
+				if performer.calls() != 1 {
+					t.Fatalf("Handle() evicted the pod %d times, expected once", performer.calls())
+				}
+				got = performer.last()
+			} else if performer.calls() != 0 {
+				t.Fatalf("Handle() evicted the pod, expected %s to be active", tc.active)
+			}
+
+			if tc.active != "" && (got.Status.ActiveInterceptorClass == nil || *got.Status.ActiveInterceptorClass != tc.active) {
+				t.Errorf("active interceptor class = %v, expected %s", got.Status.ActiveInterceptorClass, tc.active)
+			}
+			if got.Status.ActiveInterceptorCompleted != tc.completed {
+				t.Errorf("active interceptor completed = %v, expected %v", got.Status.ActiveInterceptorCompleted, tc.completed)
+			}
+			if len(got.Status.InterceptorHistory) != len(tc.history) {
+				t.Fatalf("history = %+v, expected reasons %v", got.Status.InterceptorHistory, tc.history)
+			}
+			for i, reason := range tc.history {
+				entry := got.Status.InterceptorHistory[i]
+				if entry.Reason != reason || (entry.CompletionTime != nil) != (reason != "") {
+					t.Errorf("history entry %d = %+v, expected reason %q", i, entry, reason)
+				}
+			}
+		})
+	}
+}
+
+// activate makes the interceptor class active, selected at the given time
+func activate(interceptorClass string, completed bool, selectedAt time.Time) func(status *v1alpha1.EvictionRequestStatus) {
+	return func(status *v1alpha1.EvictionRequestStatus) {
+		status.ActiveInterceptorClass = &interceptorClass
+		status.ActiveInterceptorCompleted = completed
+		status.InterceptorHistory = append(status.InterceptorHistory, selected(interceptorClass, selectedAt, ""))
+	}
+}
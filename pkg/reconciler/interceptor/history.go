@@ -0,0 +1,79 @@
+package interceptor
+
+import (
+	"time"
+
+	"code.uber.internal/apis/evictionrequest/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// _maxInterceptorHistory is the number of entries kept in .status.interceptorHistory
+const _maxInterceptorHistory = 20
+
+// appendHistoryEntry appends an entry to the interceptor history, dropping the oldest entries beyond the limit
+func appendHistoryEntry(status *v1alpha1.EvictionRequestStatus, entry v1alpha1.InterceptorHistoryEntry) {
+	status.InterceptorHistory = append(status.InterceptorHistory, entry)
+	if excess := len(status.InterceptorHistory) - _maxInterceptorHistory; excess > 0 {
+		status.InterceptorHistory = append([]v1alpha1.InterceptorHistoryEntry(nil), status.InterceptorHistory[excess:]...)
+	}
+}
+
+// activeHistoryEntry returns the history entry of the active interceptor, or nil if it has none
+// (e.g. because it was selected before the history was recorded)
+func activeHistoryEntry(status *v1alpha1.EvictionRequestStatus) *v1alpha1.InterceptorHistoryEntry {
+	if status.ActiveInterceptorClass == nil || len(status.InterceptorHistory) == 0 {
+		return nil
+	}
+	entry := &status.InterceptorHistory[len(status.InterceptorHistory)-1]
+	if entry.InterceptorClass != *status.ActiveInterceptorClass || entry.CompletionTime != nil {
+		return nil
+	}
+	return entry
+}
+
+// observeProgress copies the heartbeat and expected finish time of the active interceptor into its history
+// entry. It returns true if this is the first heartbeat of the interceptor.
+func observeProgress(entry *v1alpha1.InterceptorHistoryEntry, status *v1alpha1.EvictionRequestStatus) bool {
+	if status.ExpectedInterceptorFinishTime != nil {
+		entry.ExpectedFinishTime = status.ExpectedInterceptorFinishTime.DeepCopy()
+	}
+	if status.HeartbeatTime == nil || status.HeartbeatTime.Before(&entry.SelectionTime) {
+		return false
+	}
+
+	first := entry.FirstHeartbeatTime == nil
+	if first {
+		entry.FirstHeartbeatTime = status.HeartbeatTime.DeepCopy()
+	}
+	entry.LastHeartbeatTime = status.HeartbeatTime.DeepCopy()
+	return first
+}
+
+// finishHistoryEntry records why and when the active interceptor stopped being active, along with the
+// accuracy of its last expected finish time
+func finishHistoryEntry(status *v1alpha1.EvictionRequestStatus, reason v1alpha1.InterceptorCompletionReason, now metav1.Time) {
+	entry := activeHistoryEntry(status)
+	if entry == nil {
+		return
+	}
+
+	observeProgress(entry, status)
+	entry.CompletionTime = &now
+	entry.Reason = reason
+	if entry.ExpectedFinishTime != nil {
+		finishTimeError := int64(now.Sub(entry.ExpectedFinishTime.Time) / time.Second)
+		entry.FinishTimeErrorSeconds = &finishTimeError
+	}
+}
+
+// lastProgressTime returns the time from which the heartbeat deadline of the active interceptor is measured:
+// its last heartbeat, or its selection if it has not sent one yet
+func lastProgressTime(status *v1alpha1.EvictionRequestStatus) *metav1.Time {
+	if status.HeartbeatTime != nil {
+		return status.HeartbeatTime
+	}
+	if entry := activeHistoryEntry(status); entry != nil {
+		return &entry.SelectionTime
+	}
+	return nil
+}
@@ -2,17 +2,32 @@ package interceptor
 
 import (
 	"context"
+	"fmt"
 	"sort"
 	"time"
 
 	"code.uber.internal/apis/evictionrequest/v1alpha1"
+	"code.uber.internal/pkg/callout"
+	"code.uber.internal/pkg/config"
+	"code.uber.internal/pkg/constants"
+	"code.uber.internal/pkg/evictionpolicy"
 	"code.uber.internal/pkg/generated/clientset/versioned"
+	evreqlisters "code.uber.internal/pkg/generated/listers/evictionrequest/v1alpha1"
+	"code.uber.internal/pkg/heartbeat"
+	"code.uber.internal/pkg/interceptorclass"
 	"code.uber.internal/pkg/reconciler/eviction"
+	"code.uber.internal/pkg/reconciler/requeue"
+	"code.uber.internal/pkg/reconciler/window"
 	"go.uber.org/fx"
 	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
 	v1 "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/clock"
 )
 
 type Interface interface {
@@ -20,31 +35,58 @@ type Interface interface {
 }
 
 type interceptorHandler struct {
-	PodLister             v1.PodLister
-	EvictionRequestClient versioned.Interface
-	KubeClient            kubernetes.Interface
-	Logger                *zap.Logger
-	EvictionPerformer     eviction.Interface
+	PodLister              v1.PodLister
+	InterceptorClassLister evreqlisters.InterceptorClassLister
+	EvictionPolicyLister   evreqlisters.EvictionPolicyLister
+	NamespaceLister        v1.NamespaceLister
+	EvictionRequestClient  versioned.Interface
+	KubeClient             kubernetes.Interface
+	Logger                 *zap.Logger
+	EvictionPerformer      eviction.Interface
+	Callout                callout.Interface
+	Recorder               record.EventRecorder
+	Options                config.Options
+	Window                 window.Interface
+	Heartbeat              heartbeat.Interface
+	Clock                  clock.Clock
 }
 
 func New(params params) Interface {
 	return &interceptorHandler{
-		PodLister:             params.PodLister,
-		EvictionRequestClient: params.EvictionRequestClient,
-		KubeClient:            params.KubeClient,
-		Logger:                params.Logger,
-		EvictionPerformer:     params.EvictionPerformer,
+		PodLister:              params.PodLister,
+		InterceptorClassLister: params.InterceptorClassLister,
+		EvictionPolicyLister:   params.EvictionPolicyLister,
+		NamespaceLister:        params.NamespaceLister,
+		EvictionRequestClient:  params.EvictionRequestClient,
+		KubeClient:             params.KubeClient,
+		Logger:                 params.Logger,
+		EvictionPerformer:      params.EvictionPerformer,
+		Callout:                params.Callout,
+		Recorder:               params.Recorder,
+		Options:                params.Options,
+		Window:                 params.Window,
+		Heartbeat:              params.Heartbeat,
+		Clock:                  params.Clock,
 	}
 }
 
 type params struct {
 	fx.In
 
-	PodLister             v1.PodLister
-	EvictionRequestClient versioned.Interface
-	KubeClient            kubernetes.Interface
-	Logger                *zap.Logger
-	EvictionPerformer     eviction.Interface
+	PodLister              v1.PodLister
+	InterceptorClassLister evreqlisters.InterceptorClassLister
+	EvictionPolicyLister   evreqlisters.EvictionPolicyLister
+	NamespaceLister        v1.NamespaceLister
+	EvictionRequestClient  versioned.Interface
+	KubeClient             kubernetes.Interface
+	Logger                 *zap.Logger
+	EvictionPerformer      eviction.Interface
+	Callout                callout.Interface
+	Recorder               record.EventRecorder
+	Options                config.Options
+	Window                 window.Interface
+	Heartbeat              heartbeat.Interface
+	Clock                  clock.Clock
 }
 
 // Handle processes interceptors for an eviction request
@@ -56,35 +98,69 @@ func (i *interceptorHandler) Handle(ctx context.Context, evictionRequest *v1alph
 		return i.selectInitialInterceptor(ctx, evictionRequest, interceptors)
 	}
 
-	// State 2: Active interceptor exists and completed - select next highest priority
+	// State 2: Active interceptor is not part of the spec - resume after the interceptors that already ran
+	if i.findInterceptorIndex(interceptors, *evictionRequest.Status.ActiveInterceptorClass) < 0 {
+		return i.handleUnknownInterceptor(ctx, evictionRequest, interceptors)
+	}
+
+	// State 3: Active interceptor exists and completed - select next highest priority
 	if evictionRequest.Status.ActiveInterceptorCompleted {
 		return i.handleCompletedInterceptor(ctx, evictionRequest, interceptors)
 	}
 
-	// State 3: Active interceptor exists and not completed - check for timeout
+	// State 4: Active interceptor exists and not completed - check for timeout
 	return i.checkInterceptorTimeout(ctx, evictionRequest)
 }
 
 // sortInterceptorsByPriority sorts interceptors by priority (highest first) for consistent ordering
 func (i *interceptorHandler) sortInterceptorsByPriority(interceptors []v1alpha1.Interceptor) []v1alpha1.Interceptor {
-	sortedInterceptors := make([]v1alpha1.Interceptor, len(interceptors))
-	copy(sortedInterceptors, interceptors)
-	sort.Slice(sortedInterceptors, func(i, j int) bool {
-		return sortedInterceptors[i].Priority > sortedInterceptors[j].Priority
+	return SortByPriority(interceptors)
+}
+
+// SortByPriority returns a copy of interceptors in the order the controller selects them: highest priority
+// first, and by interceptor class for equal priorities so the order is the same on every reconcile.
+// The interceptor class is the key of the list, so only the first interceptor of a class is kept.
+func SortByPriority(interceptors []v1alpha1.Interceptor) []v1alpha1.Interceptor {
+	sortedInterceptors := make([]v1alpha1.Interceptor, 0, len(interceptors))
+	seen := make(map[string]bool, len(interceptors))
+	for _, interceptor := range interceptors {
+		if seen[interceptor.InterceptorClass] {
+			continue
+		}
+		seen[interceptor.InterceptorClass] = true
+		sortedInterceptors = append(sortedInterceptors, interceptor)
+	}
+
+	sort.SliceStable(sortedInterceptors, func(i, j int) bool {
+		if sortedInterceptors[i].Priority != sortedInterceptors[j].Priority {
+			return sortedInterceptors[i].Priority > sortedInterceptors[j].Priority
+		}
+		return sortedInterceptors[i].InterceptorClass < sortedInterceptors[j].InterceptorClass
 	})
 	return sortedInterceptors
 }
 
-// selectInitialInterceptor selects the highest priority interceptor when no active interceptor exists
+// selectInitialInterceptor selects the highest priority interceptor when no active interceptor exists.
+// If the active interceptor was cleared after some interceptors already ran, selection resumes after them.
+// The first interceptor is only selected once the eviction schedules of the pod allow the eviction.
 func (i *interceptorHandler) selectInitialInterceptor(ctx context.Context, evictionRequest *v1alpha1.EvictionRequest, interceptors []v1alpha1.Interceptor) error {
-	highestPriorityInterceptor := &interceptors[0]
-	evictionRequest.Status.ActiveInterceptorClass = &highestPriorityInterceptor.InterceptorClass
-	return i.updateEvictionRequestStatus(ctx, evictionRequest)
+	if len(evictionRequest.Status.InterceptorHistory) == 0 && evictionRequest.Spec.Target.PodRef != nil {
+		pod, err := i.PodLister.Pods(evictionRequest.Namespace).Get(evictionRequest.Spec.Target.PodRef.Name)
+		if err == nil {
+			if err := i.Window.Wait(ctx, evictionRequest, pod); err != nil {
+				return err
+			}
+		} else if !apierrors.IsNotFound(err) {
+			return fmt.Errorf("failed to get pod: %w", err)
+		}
+	}
+	return i.selectNextInterceptor(ctx, evictionRequest, interceptors, i.resumeIndex(interceptors, evictionRequest.Status.InterceptorHistory))
 }
 
 // handleCompletedInterceptor handles the case when the active interceptor has completed
 func (i *interceptorHandler) handleCompletedInterceptor(ctx context.Context, evictionRequest *v1alpha1.EvictionRequest, interceptors []v1alpha1.Interceptor) error {
 	currentIndex := i.findInterceptorIndex(interceptors, *evictionRequest.Status.ActiveInterceptorClass)
+	finishHistoryEntry(&evictionRequest.Status, v1alpha1.InterceptorCompleted, metav1.NewTime(i.Clock.Now()))
 
 	// Select next interceptor (next in priority order)
 	if currentIndex >= 0 && currentIndex+1 < len(interceptors) {
@@ -93,7 +169,36 @@ func (i *interceptorHandler) handleCompletedInterceptor(ctx context.Context, evi
 
 	// No more interceptors, proceed with direct eviction
 	i.Logger.Info("All interceptors completed, proceeding with direct eviction")
-	return i.EvictionPerformer.Perform(ctx, evictionRequest)
+	meta.RemoveStatusCondition(&evictionRequest.Status.Conditions, constants.ConditionTypeInterceptorOverdue)
+	return i.evictDirectly(ctx, evictionRequest)
+}
+
+// handleUnknownInterceptor handles an active interceptor class that is not part of .spec.interceptors, e.g.
+// because it was overwritten by a misbehaving interceptor. The open history entry is closed and selection
+// resumes after the interceptors that were already selected.
+func (i *interceptorHandler) handleUnknownInterceptor(ctx context.Context, evictionRequest *v1alpha1.EvictionRequest, interceptors []v1alpha1.Interceptor) error {
+	i.Logger.Warn("Active interceptor class is not part of the eviction request, resuming selection",
+		zap.String("interceptor_class", *evictionRequest.Status.ActiveInterceptorClass))
+
+	history := evictionRequest.Status.InterceptorHistory
+	if n := len(history); n > 0 && history[n-1].CompletionTime == nil {
+		now := metav1.NewTime(i.Clock.Now())
+		history[n-1].CompletionTime = &now
+		history[n-1].Reason = v1alpha1.InterceptorNotFound
+	}
+	return i.selectNextInterceptor(ctx, evictionRequest, interceptors, i.resumeIndex(interceptors, history))
+}
+
+// resumeIndex returns the index of the lowest priority interceptor that was already selected according to
+// the history, or -1 if none was
+func (i *interceptorHandler) resumeIndex(interceptors []v1alpha1.Interceptor, history []v1alpha1.InterceptorHistoryEntry) int {
+	resumeIndex := -1
+	for _, entry := range history {
+		if idx := i.findInterceptorIndex(interceptors, entry.InterceptorClass); idx > resumeIndex {
+			resumeIndex = idx
+		}
+	}
+	return resumeIndex
 }
 
 // findInterceptorIndex finds the index of the interceptor with the given class in the sorted list
@@ -106,36 +211,190 @@ func (i *interceptorHandler) findInterceptorIndex(interceptors []v1alpha1.Interc
 	return -1
 }
 
-// selectNextInterceptor selects the next interceptor in priority order
+// selectNextInterceptor selects the next interceptor in priority order after currentIndex, skipping
+// registered interceptor classes without a live implementation. The pod is evicted directly if all
+// remaining interceptors are skipped.
+// The progress reported by the previous interceptor is reset, so the heartbeat deadline of the selected
+// interceptor is measured from its selection.
 func (i *interceptorHandler) selectNextInterceptor(ctx context.Context, evictionRequest *v1alpha1.EvictionRequest, interceptors []v1alpha1.Interceptor, currentIndex int) error {
-	nextInterceptor := &interceptors[currentIndex+1]
-	evictionRequest.Status.ActiveInterceptorClass = &nextInterceptor.InterceptorClass
-	return i.updateEvictionRequestStatus(ctx, evictionRequest)
+	now := metav1.NewTime(i.Clock.Now())
+	meta.RemoveStatusCondition(&evictionRequest.Status.Conditions, constants.ConditionTypeInterceptorOverdue)
+	for idx := currentIndex + 1; idx < len(interceptors); idx++ {
+		nextInterceptor := &interceptors[idx]
+		if !i.isLive(nextInterceptor.InterceptorClass) {
+			i.Logger.Info("Skipping interceptor class without a live implementation",
+				zap.String("interceptor_class", nextInterceptor.InterceptorClass))
+			appendHistoryEntry(&evictionRequest.Status, v1alpha1.InterceptorHistoryEntry{
+				InterceptorClass: nextInterceptor.InterceptorClass,
+				SelectionTime:    now,
+				CompletionTime:   &now,
+				Reason:           v1alpha1.InterceptorNotLive,
+			})
+			continue
+		}
+
+		evictionRequest.Status.ActiveInterceptorClass = &nextInterceptor.InterceptorClass
+		evictionRequest.Status.ActiveInterceptorCompleted = false
+		evictionRequest.Status.HeartbeatTime = nil
+		evictionRequest.Status.ExpectedInterceptorFinishTime = nil
+		appendHistoryEntry(&evictionRequest.Status, v1alpha1.InterceptorHistoryEntry{
+			InterceptorClass: nextInterceptor.InterceptorClass,
+			SelectionTime:    now,
+		})
+		return i.updateEvictionRequestStatus(ctx, evictionRequest)
+	}
+
+	i.Logger.Info("No live interceptors left, proceeding with direct eviction")
+	return i.evictDirectly(ctx, evictionRequest)
+}
+
+// isLive reports whether an interceptor class can be selected. Classes that are not registered as an
+// InterceptorClass are always selected, as nothing is known about their implementation.
+func (i *interceptorHandler) isLive(interceptorClass string) bool {
+	class, err := interceptorclass.Get(i.InterceptorClassLister, interceptorClass)
+	if err != nil {
+		i.Logger.Warn("Failed to get interceptor class", zap.String("interceptor_class", interceptorClass), zap.Error(err))
+		return true
+	}
+	return class == nil || interceptorclass.IsLive(class, i.Clock.Now())
 }
 
-// checkInterceptorTimeout checks if the active interceptor has exceeded its deadline
+// checkInterceptorTimeout checks if the active interceptor has exceeded its deadline, measured from its
+// last heartbeat or its selection if it has not adopted the eviction request yet
 func (i *interceptorHandler) checkInterceptorTimeout(ctx context.Context, evictionRequest *v1alpha1.EvictionRequest) error {
-	if evictionRequest.Spec.HeartbeatDeadlineSeconds != nil && evictionRequest.Status.HeartbeatTime != nil {
-		deadline := time.Duration(*evictionRequest.Spec.HeartbeatDeadlineSeconds) * time.Second
-		if time.Since(evictionRequest.Status.HeartbeatTime.Time) > deadline {
-			return i.markInterceptorAsCompleted(ctx, evictionRequest, deadline)
+	now := i.Clock.Now()
+	if i.clampFutureHeartbeat(evictionRequest, now) {
+		return i.updateEvictionRequestStatus(ctx, evictionRequest)
+	}
+	i.observeLeaseHeartbeat(evictionRequest, now)
+
+	var remaining time.Duration
+	if evictionRequest.Spec.HeartbeatDeadlineSeconds != nil {
+		if progressTime := lastProgressTime(&evictionRequest.Status); progressTime != nil {
+			deadline := time.Duration(*evictionRequest.Spec.HeartbeatDeadlineSeconds) * time.Second
+			deadline = interceptorclass.HeartbeatDeadline(i.activeInterceptorClass(evictionRequest), deadline)
+			policy, err := i.evictionPolicy(evictionRequest)
+			if err != nil {
+				return err
+			}
+			deadline = evictionpolicy.HeartbeatDeadline(policy, deadline)
+			remaining = deadline - now.Sub(progressTime.Time)
+			if remaining < 0 {
+				return i.markInterceptorAsCompleted(ctx, evictionRequest, v1alpha1.InterceptorDeadlineExceeded, deadline)
+			}
+		}
+	}
+
+	// The expected finish time with grace is an additional deadline
+	if finishTimeDeadline, ok := i.finishTimeDeadline(&evictionRequest.Status); ok {
+		untilDeadline := finishTimeDeadline.Sub(now)
+		if untilDeadline < 0 {
+			return i.markInterceptorAsCompleted(ctx, evictionRequest, v1alpha1.InterceptorFinishTimeExceeded,
+				finishTimeDeadline.Sub(activeHistoryEntry(&evictionRequest.Status).SelectionTime.Time))
 		}
+		remaining = minPositive(remaining, untilDeadline)
+	}
+
+	if i.updateOverdueCondition(evictionRequest, now) {
+		return i.updateEvictionRequestStatus(ctx, evictionRequest)
+	}
+	if expectedFinishTime := evictionRequest.Status.ExpectedInterceptorFinishTime; expectedFinishTime != nil {
+		remaining = minPositive(remaining, expectedFinishTime.Sub(now))
+	}
+
+	// Interceptor classes served by a callout endpoint are driven by the controller itself
+	if endpoint, ok := i.Callout.Endpoint(*evictionRequest.Status.ActiveInterceptorClass); ok {
+		return i.handleCallout(ctx, evictionRequest, endpoint)
+	}
+
+	// Record when the interceptor adopted the eviction request
+	if entry := activeHistoryEntry(&evictionRequest.Status); entry != nil && observeProgress(entry, &evictionRequest.Status) {
+		return i.updateEvictionRequestStatus(ctx, evictionRequest)
 	}
 
 	// Interceptor is still active and within deadline, wait for progress
 	i.Logger.Info("Waiting for interceptor progress", zap.String("interceptor_class", *evictionRequest.Status.ActiveInterceptorClass))
+	if remaining > 0 {
+		return requeue.After(remaining)
+	}
 	return nil
 }
 
+// activeInterceptorClass returns the registered InterceptorClass of the active interceptor, if any
+func (i *interceptorHandler) activeInterceptorClass(evictionRequest *v1alpha1.EvictionRequest) *v1alpha1.InterceptorClass {
+	class, err := interceptorclass.Get(i.InterceptorClassLister, *evictionRequest.Status.ActiveInterceptorClass)
+	if err != nil {
+		i.Logger.Warn("Failed to get interceptor class", zap.String("interceptor_class", *evictionRequest.Status.ActiveInterceptorClass), zap.Error(err))
+		return nil
+	}
+	return class
+}
+
 // markInterceptorAsCompleted marks the active interceptor as completed due to timeout
-func (i *interceptorHandler) markInterceptorAsCompleted(ctx context.Context, evictionRequest *v1alpha1.EvictionRequest, deadline time.Duration) error {
+func (i *interceptorHandler) markInterceptorAsCompleted(ctx context.Context, evictionRequest *v1alpha1.EvictionRequest, reason v1alpha1.InterceptorCompletionReason, deadline time.Duration) error {
 	i.Logger.Info("Interceptor deadline exceeded, marking as completed",
 		zap.String("interceptor_class", *evictionRequest.Status.ActiveInterceptorClass),
+		zap.String("reason", string(reason)),
 		zap.Duration("deadline", deadline))
 	evictionRequest.Status.ActiveInterceptorCompleted = true
+	finishHistoryEntry(&evictionRequest.Status, reason, metav1.NewTime(i.Clock.Now()))
+	return i.updateEvictionRequestStatus(ctx, evictionRequest)
+}
+
+// evictionPolicy returns the effective EvictionPolicy of the namespace of the eviction request. If it cannot be
+// resolved, the error is returned so that the eviction request is retried instead of processed without a policy.
+func (i *interceptorHandler) evictionPolicy(evictionRequest *v1alpha1.EvictionRequest) (*v1alpha1.EvictionPolicySpec, error) {
+	policy, err := evictionpolicy.Resolve(i.EvictionPolicyLister, i.NamespaceLister, evictionRequest.Namespace, i.Logger)
+	if err != nil {
+		i.Logger.Warn("Failed to resolve eviction policy", zap.String("namespace", evictionRequest.Namespace), zap.Error(err))
+		return nil, fmt.Errorf("failed to resolve eviction policy: %w", err)
+	}
+	return policy, nil
+}
+
+// evictDirectly evicts the pod through the Eviction API once no interceptor is left. If none of the
+// interceptors completed and the eviction policy of the namespace does not allow the fallback, the pod is
+// left alone and the Evicted condition explains why.
+func (i *interceptorHandler) evictDirectly(ctx context.Context, evictionRequest *v1alpha1.EvictionRequest) error {
+	for _, entry := range evictionRequest.Status.InterceptorHistory {
+		if entry.Reason == v1alpha1.InterceptorCompleted {
+			return i.EvictionPerformer.Perform(ctx, evictionRequest)
+		}
+	}
+	policy, err := i.evictionPolicy(evictionRequest)
+	if err != nil {
+		return err
+	}
+	if evictionpolicy.DirectEvictionFallbackAllowed(policy) {
+		return i.EvictionPerformer.Perform(ctx, evictionRequest)
+	}
+
+	if condition := meta.FindStatusCondition(evictionRequest.Status.Conditions, constants.ConditionTypeEvicted); condition != nil &&
+		condition.Reason == constants.ReasonDirectEvictionFallbackNotAllowed {
+		return nil
+	}
+
+	message := "No interceptor completed and the eviction policy of the namespace does not allow falling back to the Eviction API"
+	i.Logger.Warn("Direct eviction fallback not allowed", zap.String("eviction_request", evictionRequest.Name))
+	i.Recorder.Event(evictionRequest, corev1.EventTypeWarning, constants.ReasonDirectEvictionFallbackNotAllowed, message)
+	meta.SetStatusCondition(&evictionRequest.Status.Conditions, metav1.Condition{
+		Type:               constants.ConditionTypeEvicted,
+		Status:             metav1.ConditionFalse,
+		LastTransitionTime: metav1.NewTime(i.Clock.Now()),
+		Reason:             constants.ReasonDirectEvictionFallbackNotAllowed,
+		Message:            message,
+	})
 	return i.updateEvictionRequestStatus(ctx, evictionRequest)
 }
 
+// minPositive returns the smaller of two durations, ignoring durations that are not positive
+func minPositive(a, b time.Duration) time.Duration {
+	if a <= 0 || (b > 0 && b < a) {
+		return b
+	}
+	return a
+}
+
 // updateEvictionRequestStatus updates the status of the eviction request
 func (i *interceptorHandler) updateEvictionRequestStatus(ctx context.Context, evictionRequest *v1alpha1.EvictionRequest) error {
 	_, err := i.EvictionRequestClient.EvictionrequestV1alpha1().EvictionRequests(evictionRequest.Namespace).UpdateStatus(ctx, evictionRequest, metav1.UpdateOptions{})
@@ -0,0 +1,364 @@
+package interceptor
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"reflect"
+	"slices"
+	"sort"
+	"sync"
+	"testing"
+	"testing/quick"
+	"time"
+
+	"code.uber.internal/apis/evictionrequest/v1alpha1"
+	"code.uber.internal/pkg/callout"
+	"code.uber.internal/pkg/config"
+	evreqfake "code.uber.internal/pkg/generated/clientset/versioned/fake"
+	evreqlisters "code.uber.internal/pkg/generated/listers/evictionrequest/v1alpha1"
+	"code.uber.internal/pkg/heartbeat"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	corev1listers "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	clocktesting "k8s.io/utils/clock/testing"
+)
+
+// _classes is the alphabet of the generated interceptor classes. It is small so that generated lists contain
+// duplicate classes and equal priorities.
+var _classes = []string{"a.example.com", "b.example.com", "c.example.com", "d.example.com", "e.example.com", "f.example.com"}
+
+// interceptorList is a list of interceptors generated by testing/quick
+type interceptorList []v1alpha1.Interceptor
+
+// Generate implements quick.Generator
+func (interceptorList) Generate(r *rand.Rand, size int) reflect.Value {
+	interceptors := make(interceptorList, r.Intn(min(size, 2*len(_classes))+1))
+	for i := range interceptors {
+		interceptors[i] = v1alpha1.Interceptor{
+			InterceptorClass: _classes[r.Intn(len(_classes))],
+			Priority:         int32(r.Intn(3)) * 100,
+		}
+	}
+	return reflect.ValueOf(interceptors)
+}
+
+// uniqueInterceptorList is a list of interceptors with distinct classes generated by testing/quick
+type uniqueInterceptorList []v1alpha1.Interceptor
+
+// Generate implements quick.Generator
+func (uniqueInterceptorList) Generate(r *rand.Rand, size int) reflect.Value {
+	classes := slices.Clone(_classes)
+	r.Shuffle(len(classes), func(i, j int) { classes[i], classes[j] = classes[j], classes[i] })
+	interceptors := make(uniqueInterceptorList, 1+r.Intn(len(classes)))
+	for i := range interceptors {
+		interceptors[i] = v1alpha1.Interceptor{InterceptorClass: classes[i], Priority: int32(r.Intn(3)) * 100}
+	}
+	return reflect.ValueOf(interceptors)
+}
+
+func TestSortByPriorityIsOrdered(t *testing.T) {
+	property := func(interceptors interceptorList) bool {
+		sorted := SortByPriority(interceptors)
+		return sort.SliceIsSorted(sorted, func(i, j int) bool {
+			if sorted[i].Priority != sorted[j].Priority {
+				return sorted[i].Priority > sorted[j].Priority
+			}
+			return sorted[i].InterceptorClass < sorted[j].InterceptorClass
+		})
+	}
+	if err := quick.Check(property, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestSortByPriorityKeepsFirstInterceptorOfEachClass(t *testing.T) {
+	property := func(interceptors interceptorList) bool {
+		first := map[string]v1alpha1.Interceptor{}
+		for _, interceptor := range interceptors {
+			if _, ok := first[interceptor.InterceptorClass]; !ok {
+				first[interceptor.InterceptorClass] = interceptor
+			}
+		}
+
+		sorted := SortByPriority(interceptors)
+		if len(sorted) != len(first) {
+			return false
+		}
+		for _, interceptor := range sorted {
+			if !reflect.DeepEqual(interceptor, first[interceptor.InterceptorClass]) {
+				return false
+			}
+		}
+		return true
+	}
+	if err := quick.Check(property, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestSortByPriorityIsIndependentOfInputOrder(t *testing.T) {
+	property := func(interceptors uniqueInterceptorList, seed int64) bool {
+		shuffled := slices.Clone(interceptors)
+		rand.New(rand.NewSource(seed)).Shuffle(len(shuffled), func(i, j int) { shuffled[i], shuffled[j] = shuffled[j], shuffled[i] })
+		return reflect.DeepEqual(SortByPriority(interceptors), SortByPriority(shuffled))
+	}
+	if err := quick.Check(property, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestSortByPriorityIsIdempotent(t *testing.T) {
+	property := func(interceptors interceptorList) bool {
+		sorted := SortByPriority(interceptors)
+		return reflect.DeepEqual(sorted, SortByPriority(sorted))
+	}
+	if err := quick.Check(property, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestSortByPriorityDoesNotModifyInput(t *testing.T) {
+	property := func(interceptors interceptorList) bool {
+		original := slices.Clone(interceptors)
+		SortByPriority(interceptors)
+		return reflect.DeepEqual(original, interceptors)
+	}
+	if err := quick.Check(property, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+// TestHandleResumesAfterSelectedInterceptors checks that selection resumes after the lowest priority
+// interceptor in the history, whether the active interceptor class was cleared (e.g. by a restart of an
+// interceptor that reset the status) or replaced by a class that is not part of the spec
+func TestHandleResumesAfterSelectedInterceptors(t *testing.T) {
+	property := func(interceptors uniqueInterceptorList, selected uint8, unknownActive bool) bool {
+		sorted := SortByPriority(interceptors)
+		selectedCount := 1 + int(selected)%len(sorted)
+
+		evictionRequest := newEvictionRequest(interceptors...)
+		for _, interceptor := range sorted[:selectedCount] {
+			completion := metav1.NewTime(_now)
+			evictionRequest.Status.InterceptorHistory = append(evictionRequest.Status.InterceptorHistory, v1alpha1.InterceptorHistoryEntry{
+				InterceptorClass: interceptor.InterceptorClass,
+				SelectionTime:    metav1.NewTime(_now.Add(-time.Hour)),
+				CompletionTime:   &completion,
+				Reason:           v1alpha1.InterceptorCompleted,
+			})
+		}
+		if unknownActive {
+			unknown := "unknown.example.com"
+			evictionRequest.Status.ActiveInterceptorClass = &unknown
+			evictionRequest.Status.InterceptorHistory = append(evictionRequest.Status.InterceptorHistory, v1alpha1.InterceptorHistoryEntry{
+				InterceptorClass: unknown,
+				SelectionTime:    metav1.NewTime(_now.Add(-time.Minute)),
+			})
+		}
+
+		handler, performer := newTestHandler(t, evictionRequest)
+		if err := handler.Handle(context.Background(), evictionRequest); err != nil {
+			t.Logf("Handle() error = %v", err)
+			return false
+		}
+		got := getEvictionRequest(t, handler, evictionRequest)
+		if performer.calls() > 0 {
+			// The status is written along with the eviction
+			got = performer.last()
+		}
+
+		if unknownActive {
+			entry := got.Status.InterceptorHistory[selectedCount]
+			if entry.CompletionTime == nil || entry.Reason != v1alpha1.InterceptorNotFound {
+				t.Logf("unknown interceptor entry = %+v", entry)
+				return false
+			}
+		}
+		if selectedCount == len(sorted) {
+			// All interceptors already ran, the pod is evicted
+			return performer.calls() == 1
+		}
+		return performer.calls() == 0 &&
+			got.Status.ActiveInterceptorClass != nil &&
+			*got.Status.ActiveInterceptorClass == sorted[selectedCount].InterceptorClass &&
+			!got.Status.ActiveInterceptorCompleted
+	}
+	if err := quick.Check(property, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+// TestHandleSelectsInPriorityOrder checks that completing the active interceptor on every reconcile selects
+// every interceptor exactly once, in the order of SortByPriority, and then evicts the pod
+func TestHandleSelectsInPriorityOrder(t *testing.T) {
+	property := func(interceptors interceptorList) bool {
+		if len(interceptors) == 0 {
+			return true
+		}
+		evictionRequest := newEvictionRequest(interceptors...)
+		handler, performer := newTestHandler(t, evictionRequest)
+
+		var selected []string
+		for range len(SortByPriority(interceptors)) + 1 {
+			current := getEvictionRequest(t, handler, evictionRequest)
+			if current.Status.ActiveInterceptorClass != nil {
+				current.Status.ActiveInterceptorCompleted = true
+			}
+			if err := handler.Handle(context.Background(), current); err != nil {
+				t.Logf("Handle() error = %v", err)
+				return false
+			}
+			if got := getEvictionRequest(t, handler, evictionRequest); performer.calls() == 0 {
+				selected = append(selected, *got.Status.ActiveInterceptorClass)
+			}
+		}
+
+		var expected []string
+		for _, interceptor := range SortByPriority(interceptors) {
+			expected = append(expected, interceptor.InterceptorClass)
+		}
+		return performer.calls() == 1 && slices.Equal(selected, expected)
+	}
+	if err := quick.Check(property, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+// TestHandleFailsClosedWithoutEvictionPolicy checks that the pod is not evicted directly while the eviction
+// policy of its namespace cannot be resolved, since the policy may forbid the fallback
+func TestHandleFailsClosedWithoutEvictionPolicy(t *testing.T) {
+	evictionRequest := newEvictionRequest()
+	handler, performer := newTestHandler(t, evictionRequest)
+	policies := newIndexer()
+	if err := policies.Add(&v1alpha1.EvictionPolicy{ObjectMeta: metav1.ObjectMeta{Name: "baseline"}}); err != nil {
+		t.Fatal(err)
+	}
+	// The namespace of the eviction request is not in the lister
+	handler.EvictionPolicyLister = evreqlisters.NewEvictionPolicyLister(policies)
+
+	if err := handler.Handle(context.Background(), evictionRequest); err == nil {
+		t.Error("Handle() succeeded, expected the eviction policy error")
+	}
+	if performer.calls() != 0 {
+		t.Errorf("Handle() evicted the pod without its eviction policy")
+	}
+}
+
+var _now = time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// newEvictionRequest returns an eviction request with the given interceptors, targeting a pod that does
+// not exist
+func newEvictionRequest(interceptors ...v1alpha1.Interceptor) *v1alpha1.EvictionRequest {
+	heartbeatDeadlineSeconds := int32(1800)
+	return &v1alpha1.EvictionRequest{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "pod", UID: "pod-evreq-uid"},
+		Spec: v1alpha1.EvictionRequestSpec{
+			Type:                     v1alpha1.Soft,
+			Target:                   v1alpha1.EvictionTarget{PodRef: &v1alpha1.LocalPodReference{Name: "pod", UID: "pod-uid"}},
+			Requesters:               []v1alpha1.Requester{{Name: "requester"}},
+			Interceptors:             interceptors,
+			HeartbeatDeadlineSeconds: &heartbeatDeadlineSeconds,
+		},
+	}
+}
+
+// newTestHandler returns an interceptor handler whose status writes go to a fake clientset holding the
+// eviction request. No interceptor class is registered, so all classes are live.
+func newTestHandler(t *testing.T, evictionRequest *v1alpha1.EvictionRequest) (*interceptorHandler, *fakePerformer) {
+	t.Helper()
+
+	performer := &fakePerformer{}
+	return &interceptorHandler{
+		PodLister:              corev1listers.NewPodLister(newIndexer()),
+		InterceptorClassLister: evreqlisters.NewInterceptorClassLister(newIndexer()),
+		EvictionPolicyLister:   evreqlisters.NewEvictionPolicyLister(newIndexer()),
+		NamespaceLister:        corev1listers.NewNamespaceLister(newIndexer()),
+		EvictionRequestClient:  evreqfake.NewSimpleClientset(evictionRequest.DeepCopy()),
+		KubeClient:             fake.NewClientset(),
+		Logger:                 zap.NewNop(),
+		EvictionPerformer:      performer,
+		Callout:                noCallout{},
+		Recorder:               record.NewFakeRecorder(100),
+		Options:                config.Options{},
+		Window:                 noWindow{},
+		Heartbeat:              noHeartbeat{},
+		Clock:                  clocktesting.NewFakeClock(_now),
+	}, performer
+}
+
+func newIndexer() cache.Indexer {
+	return cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+}
+
+// getEvictionRequest returns the eviction request as written by the handler
+func getEvictionRequest(t *testing.T, handler *interceptorHandler, evictionRequest *v1alpha1.EvictionRequest) *v1alpha1.EvictionRequest {
+	t.Helper()
+
+	got, err := handler.EvictionRequestClient.EvictionrequestV1alpha1().EvictionRequests(evictionRequest.Namespace).
+		Get(context.Background(), evictionRequest.Name, metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("failed to get eviction request: %v", err)
+	}
+	return got
+}
+
+// fakePerformer records the evictions instead of performing them
+type fakePerformer struct {
+	mu               sync.Mutex
+	evictionRequests []*v1alpha1.EvictionRequest
+}
+
+func (p *fakePerformer) Perform(_ context.Context, evictionRequest *v1alpha1.EvictionRequest) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.evictionRequests = append(p.evictionRequests, evictionRequest.DeepCopy())
+	return nil
+}
+
+func (p *fakePerformer) calls() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return len(p.evictionRequests)
+}
+
+// last returns the eviction request of the last eviction
+func (p *fakePerformer) last() *v1alpha1.EvictionRequest {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.evictionRequests[len(p.evictionRequests)-1]
+}
+
+// noCallout serves no interceptor class
+type noCallout struct{}
+
+func (noCallout) Endpoint(string) (callout.Endpoint, bool) {
+	return callout.Endpoint{}, false
+}
+
+func (noCallout) Call(context.Context, callout.Endpoint, *v1alpha1.EvictionRequest) (*callout.Response, error) {
+	return nil, fmt.Errorf("no callout")
+}
+
+// noWindow never holds back an eviction
+type noWindow struct{}
+
+func (noWindow) Wait(context.Context, *v1alpha1.EvictionRequest, *corev1.Pod) error {
+	return nil
+}
+
+// noHeartbeat has no heartbeat Leases
+type noHeartbeat struct{}
+
+func (noHeartbeat) Start(<-chan struct{}) map[reflect.Type]bool {
+	return nil
+}
+
+func (noHeartbeat) RenewTime(*v1alpha1.EvictionRequest) *metav1.Time {
+	return nil
+}
+
+var _ heartbeat.Interface = noHeartbeat{}
@@ -1,17 +1,25 @@
 package reconciler
 
 import (
+	"code.uber.internal/pkg/callout"
+	"code.uber.internal/pkg/heartbeat"
+	"code.uber.internal/pkg/reconciler/budget"
 	"code.uber.internal/pkg/reconciler/eviction"
 	"code.uber.internal/pkg/reconciler/interceptor"
 	"code.uber.internal/pkg/reconciler/status"
+	"code.uber.internal/pkg/reconciler/window"
 	"go.uber.org/fx"
 )
 
 var Module = fx.Options(
 	fx.Provide(
+		budget.New,
+		callout.New,
 		eviction.New,
+		heartbeat.New,
 		interceptor.New,
 		status.New,
+		window.New,
 		New,
 	),
 )
@@ -2,19 +2,37 @@ package reconciler
 
 import (
 	"context"
+	"fmt"
+	"strings"
+	"time"
 
 	"code.uber.internal/apis/evictionrequest/v1alpha1"
+	"code.uber.internal/pkg/constants"
+	"code.uber.internal/pkg/duplicate"
+	"code.uber.internal/pkg/evictionpolicy"
 	"code.uber.internal/pkg/generated/clientset/versioned"
+	evreqlisters "code.uber.internal/pkg/generated/listers/evictionrequest/v1alpha1"
 	"code.uber.internal/pkg/reconciler/eviction"
 	"code.uber.internal/pkg/reconciler/interceptor"
+	"code.uber.internal/pkg/reconciler/requeue"
 	"go.uber.org/fx"
 	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/client-go/kubernetes"
 	v1 "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/clock"
 )
 
+// _podCacheSyncDelay is the delay before an eviction request whose target pod exists but is not in the pod cache
+// yet is reconciled again
+const _podCacheSyncDelay = 5 * time.Second
+
 type Interface interface {
 	ReconcileEvictionRequest(ctx context.Context, evictionRequest *v1alpha1.EvictionRequest) error
 }
@@ -22,47 +40,79 @@ type params struct {
 	fx.In
 
 	PodLister             v1.PodLister
+	NamespaceLister       v1.NamespaceLister
+	EvictionPolicyLister  evreqlisters.EvictionPolicyLister
+	EvictionRequestLister evreqlisters.EvictionRequestLister
 	EvictionRequestClient versioned.Interface
 	KubeClient            kubernetes.Interface
 	Logger                *zap.Logger
+	Recorder              record.EventRecorder
 	InterceptorHandler    interceptor.Interface
 	EvictionPerformer     eviction.Interface
+	Clock                 clock.Clock
 }
 
 // Reconciler reconciles EvictionRequest resources
 type reconciler struct {
-	// pod lister
-	podLister v1.PodLister
+	// listers
+	podLister             v1.PodLister
+	namespaceLister       v1.NamespaceLister
+	evictionPolicyLister  evreqlisters.EvictionPolicyLister
+	evictionRequestLister evreqlisters.EvictionRequestLister
 
 	// eviction request client
 	evictionRequestClient versioned.Interface
 	kubeClient            kubernetes.Interface
 
 	// misc
-	logger *zap.Logger
+	logger   *zap.Logger
+	recorder record.EventRecorder
 
 	interceptorHandler interceptor.Interface
 	evictionPerformer  eviction.Interface
+	clock              clock.Clock
 }
 
 // New creates a new Reconciler
 func New(params params) Interface {
 	return &reconciler{
 		podLister:             params.PodLister,
+		namespaceLister:       params.NamespaceLister,
+		evictionPolicyLister:  params.EvictionPolicyLister,
+		evictionRequestLister: params.EvictionRequestLister,
 		evictionRequestClient: params.EvictionRequestClient,
 		kubeClient:            params.KubeClient,
 		logger:                params.Logger,
+		recorder:              params.Recorder,
 		interceptorHandler:    params.InterceptorHandler,
 		evictionPerformer:     params.EvictionPerformer,
+		clock:                 params.Clock,
 	}
 }
 
 // ReconcileEvictionRequest is the main reconciliation loop for EvictionRequest resources
 func (r *reconciler) ReconcileEvictionRequest(ctx context.Context, evictionRequest *v1alpha1.EvictionRequest) error {
+	// Node targets of v1beta1 are not processed yet
+	if evictionRequest.Spec.Target.PodRef == nil {
+		r.logger.Debug("Eviction request has no pod target, skipping", zap.String("name", evictionRequest.Name))
+		return nil
+	}
+
 	pod, err := r.podLister.Pods(evictionRequest.Namespace).Get(evictionRequest.Spec.Target.PodRef.Name)
 	if apierrors.IsNotFound(err) {
-		r.logger.Info("Pod in pod reference not found, skipping...")
-		return nil
+		// The pod cache can lag behind the API server, e.g. for a pod created right before the eviction request,
+		// so the miss is confirmed before the eviction request is completed for good
+		_, err := r.kubeClient.CoreV1().Pods(evictionRequest.Namespace).Get(ctx, evictionRequest.Spec.Target.PodRef.Name, metav1.GetOptions{})
+		if err == nil {
+			r.logger.Debug("Pod in pod reference not in the pod cache yet, requeueing", zap.String("name", evictionRequest.Name))
+			return requeue.After(_podCacheSyncDelay)
+		}
+		if !apierrors.IsNotFound(err) {
+			r.logger.Error("Failed to get pod", zap.Error(err))
+			return err
+		}
+		r.logger.Info("Pod in pod reference not found, completing eviction request")
+		return r.complete(ctx, evictionRequest, constants.ReasonPodNotFound, "Target pod no longer exists")
 	}
 	if err != nil {
 		r.logger.Error("Failed to get pod", zap.Error(err))
@@ -72,10 +122,29 @@ func (r *reconciler) ReconcileEvictionRequest(ctx context.Context, evictionReque
 	// Verify pod UID matches
 	if string(pod.UID) != evictionRequest.Spec.Target.PodRef.UID {
 		r.logger.Warn("Pod UID mismatch", zap.String("expected", evictionRequest.Spec.Target.PodRef.UID), zap.String("actual", string(pod.UID)))
+		return r.targetReplaced(ctx, evictionRequest, pod)
+	}
+
+	// An eviction request without requesters is canceled and not processed until a requester is added again
+	if len(evictionRequest.Spec.Requesters) == 0 {
+		r.logger.Debug("Eviction request has no requesters, skipping", zap.String("name", evictionRequest.Name))
 		return nil
 	}
 
-	if evictionRequest.Status.EvictionRequestCancellationPolicy == "" {
+	// Only the primary of the eviction requests targeting the pod is processed
+	primary, err := r.primary(evictionRequest)
+	if err != nil {
+		return err
+	}
+	if primary != nil && primary.UID != evictionRequest.UID {
+		return r.reportDuplicate(ctx, evictionRequest, primary)
+	}
+
+	forbidNotAllowed, err := r.forbidNotAllowed(evictionRequest)
+	if err != nil {
+		return err
+	}
+	if evictionRequest.Status.EvictionRequestCancellationPolicy == "" || forbidNotAllowed {
 		evictionRequest.Status.EvictionRequestCancellationPolicy = v1alpha1.Allow
 		_, err := r.evictionRequestClient.EvictionrequestV1alpha1().EvictionRequests(evictionRequest.Namespace).UpdateStatus(ctx, evictionRequest, metav1.UpdateOptions{})
 		if err != nil {
@@ -92,3 +161,178 @@ func (r *reconciler) ReconcileEvictionRequest(ctx context.Context, evictionReque
 	// No interceptors, proceed with eviction
 	return r.evictionPerformer.Perform(ctx, evictionRequest)
 }
+
+// complete sets the Complete condition of an eviction request whose target pod is gone, which starts its
+// TTL after completion
+func (r *reconciler) complete(ctx context.Context, evictionRequest *v1alpha1.EvictionRequest, reason, message string) error {
+	if meta.IsStatusConditionTrue(evictionRequest.Status.Conditions, string(v1alpha1.EvictionRequestComplete)) {
+		return nil
+	}
+
+	meta.SetStatusCondition(&evictionRequest.Status.Conditions, metav1.Condition{
+		Type:               string(v1alpha1.EvictionRequestComplete),
+		Status:             metav1.ConditionTrue,
+		LastTransitionTime: metav1.NewTime(r.clock.Now()),
+		Reason:             reason,
+		Message:            message,
+	})
+	if evictionRequest.Status.EvictionRequestCancellationPolicy == "" {
+		evictionRequest.Status.EvictionRequestCancellationPolicy = v1alpha1.Allow
+	}
+	if _, err := r.evictionRequestClient.EvictionrequestV1alpha1().EvictionRequests(evictionRequest.Namespace).UpdateStatus(ctx, evictionRequest, metav1.UpdateOptions{}); err != nil {
+		r.logger.Error("Failed to update eviction request status", zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+// targetReplaced completes an eviction request whose target pod was replaced by a pod with the same name. With
+// RetargetByNameAnnotation, an eviction request with the same spec is created for the new pod first.
+func (r *reconciler) targetReplaced(ctx context.Context, evictionRequest *v1alpha1.EvictionRequest, pod *corev1.Pod) error {
+	if meta.IsStatusConditionTrue(evictionRequest.Status.Conditions, string(v1alpha1.EvictionRequestComplete)) {
+		return nil
+	}
+
+	message := fmt.Sprintf("Target pod %s was replaced by a pod with UID %s", pod.Name, pod.UID)
+	if evictionRequest.Annotations[v1alpha1.RetargetByNameAnnotation] == "true" && duplicate.IsActive(evictionRequest) && pod.DeletionTimestamp == nil {
+		name, err := r.retarget(ctx, evictionRequest, pod)
+		if err != nil {
+			return err
+		}
+		message = fmt.Sprintf("%s, retargeted by eviction request %s", message, name)
+		r.recorder.Event(evictionRequest, corev1.EventTypeNormal, constants.ReasonRetargeted, message)
+	} else {
+		r.recorder.Event(evictionRequest, corev1.EventTypeWarning, constants.ReasonTargetReplaced, message)
+	}
+	return r.complete(ctx, evictionRequest, constants.ReasonTargetReplaced, message)
+}
+
+// retarget returns the eviction request targeting the replacement of the pod, creating it with the spec of the
+// given eviction request unless the new pod is already targeted
+func (r *reconciler) retarget(ctx context.Context, evictionRequest *v1alpha1.EvictionRequest, pod *corev1.Pod) (string, error) {
+	evictionRequests, err := r.evictionRequestLister.EvictionRequests(evictionRequest.Namespace).List(labels.Everything())
+	if err != nil {
+		r.logger.Error("Failed to list eviction requests", zap.Error(err))
+		return "", err
+	}
+	if existing := duplicate.Primary(duplicate.Active(evictionRequests, string(pod.UID))); existing != nil {
+		return existing.Name, nil
+	}
+
+	spec := evictionRequest.Spec.DeepCopy()
+	spec.Target.PodRef = &v1alpha1.LocalPodReference{Name: pod.Name, UID: string(pod.UID)}
+	retargeted := &v1alpha1.EvictionRequest{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        retargetName(evictionRequest.Name, string(pod.UID)),
+			Namespace:   evictionRequest.Namespace,
+			Labels:      evictionRequest.Labels,
+			Annotations: map[string]string{v1alpha1.RetargetByNameAnnotation: "true"},
+		},
+		Spec: *spec,
+	}
+	_, err = r.evictionRequestClient.EvictionrequestV1alpha1().EvictionRequests(evictionRequest.Namespace).Create(ctx, retargeted, metav1.CreateOptions{})
+	if err != nil && !apierrors.IsAlreadyExists(err) {
+		r.logger.Error("Failed to create retargeted eviction request", zap.Error(err))
+		return "", err
+	}
+	r.logger.Info("Retargeted eviction request",
+		zap.String("eviction_request", evictionRequest.Name),
+		zap.String("retargeted_eviction_request", retargeted.Name))
+	return retargeted.Name, nil
+}
+
+// retargetName derives the name of a retargeted eviction request from the name of the original one and the
+// UID of the new pod, so that retries create it only once
+func retargetName(name, podUID string) string {
+	suffix := "-" + strings.ReplaceAll(podUID, "-", "")
+	if len(suffix) > 9 {
+		suffix = suffix[:9]
+	}
+	if max := validation.DNS1123SubdomainMaxLength - len(suffix); len(name) > max {
+		name = strings.TrimRight(name[:max], ".-")
+	}
+	return name + suffix
+}
+
+// forbidNotAllowed reports whether the eviction request forbids its cancellation although the eviction policy
+// of its namespace does not allow it, e.g. because the webhook was bypassed. If the eviction policy cannot be
+// resolved, the error is returned so that the eviction request is retried.
+func (r *reconciler) forbidNotAllowed(evictionRequest *v1alpha1.EvictionRequest) (bool, error) {
+	if evictionRequest.Status.EvictionRequestCancellationPolicy != v1alpha1.Forbid {
+		return false, nil
+	}
+
+	policy, err := evictionpolicy.Resolve(r.evictionPolicyLister, r.namespaceLister, evictionRequest.Namespace, r.logger)
+	if err != nil {
+		r.logger.Warn("Failed to resolve eviction policy", zap.String("namespace", evictionRequest.Namespace), zap.Error(err))
+		return false, fmt.Errorf("failed to resolve eviction policy: %w", err)
+	}
+	if evictionpolicy.ForbidAllowed(policy) {
+		return false, nil
+	}
+
+	r.logger.Warn("Resetting cancellation policy not allowed by the eviction policy", zap.String("eviction_request", evictionRequest.Name))
+	r.recorder.Event(evictionRequest, corev1.EventTypeWarning, constants.ReasonForbidNotAllowed,
+		"Cancellation policy Forbid is not allowed by the eviction policy of the namespace, resetting it to Allow")
+	return true, nil
+}
+
+// primary returns the eviction request processed for the pod targeted by the given one, or nil if the given
+// eviction request is not active
+func (r *reconciler) primary(evictionRequest *v1alpha1.EvictionRequest) (*v1alpha1.EvictionRequest, error) {
+	if !duplicate.IsActive(evictionRequest) {
+		return nil, nil
+	}
+
+	evictionRequests, err := r.evictionRequestLister.EvictionRequests(evictionRequest.Namespace).List(labels.Everything())
+	if err != nil {
+		r.logger.Error("Failed to list eviction requests", zap.Error(err))
+		return nil, err
+	}
+	primary := duplicate.Primary(duplicate.Active(evictionRequests, evictionRequest.Spec.Target.PodRef.UID))
+	if primary == nil || primary.UID == evictionRequest.UID {
+		// A former duplicate that became the primary is persisted with the next status update
+		if meta.IsStatusConditionTrue(evictionRequest.Status.Conditions, constants.ConditionTypeDuplicate) {
+			meta.SetStatusCondition(&evictionRequest.Status.Conditions, metav1.Condition{
+				Type:               constants.ConditionTypeDuplicate,
+				Status:             metav1.ConditionFalse,
+				LastTransitionTime: metav1.NewTime(r.clock.Now()),
+				Reason:             constants.ReasonPrimaryEvictionRequest,
+				Message:            "Eviction request is the primary eviction request of the pod",
+			})
+		}
+		return evictionRequest, nil
+	}
+	return primary, nil
+}
+
+// reportDuplicate sets the Duplicate condition of an eviction request that is not processed because an older
+// one targets the same pod. It takes over once the primary is canceled or deleted.
+func (r *reconciler) reportDuplicate(ctx context.Context, evictionRequest, primary *v1alpha1.EvictionRequest) error {
+	message := fmt.Sprintf("Pod %s is already targeted by eviction request %s, add the requesters to its .spec.requesters instead",
+		evictionRequest.Spec.Target.PodRef.Name, primary.Name)
+	condition := meta.FindStatusCondition(evictionRequest.Status.Conditions, constants.ConditionTypeDuplicate)
+	if condition != nil && condition.Status == metav1.ConditionTrue && condition.Message == message {
+		return nil
+	}
+
+	r.logger.Warn("Duplicate eviction request",
+		zap.String("eviction_request", evictionRequest.Name),
+		zap.String("primary_eviction_request", primary.Name))
+	r.recorder.Event(evictionRequest, corev1.EventTypeWarning, constants.ReasonDuplicateEvictionRequest, message)
+	meta.SetStatusCondition(&evictionRequest.Status.Conditions, metav1.Condition{
+		Type:               constants.ConditionTypeDuplicate,
+		Status:             metav1.ConditionTrue,
+		LastTransitionTime: metav1.NewTime(r.clock.Now()),
+		Reason:             constants.ReasonDuplicateEvictionRequest,
+		Message:            message,
+	})
+	if evictionRequest.Status.EvictionRequestCancellationPolicy == "" {
+		evictionRequest.Status.EvictionRequestCancellationPolicy = v1alpha1.Allow
+	}
+	if _, err := r.evictionRequestClient.EvictionrequestV1alpha1().EvictionRequests(evictionRequest.Namespace).UpdateStatus(ctx, evictionRequest, metav1.UpdateOptions{}); err != nil {
+		r.logger.Error("Failed to update eviction request status", zap.Error(err))
+		return err
+	}
+	return nil
+}
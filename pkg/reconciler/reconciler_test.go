@@ -0,0 +1,242 @@
+package reconciler_test
+
+import (
+	"strings"
+	"testing"
+
+	"code.uber.internal/apis/evictionrequest/v1alpha1"
+	"code.uber.internal/pkg/constants"
+	"code.uber.internal/pkg/reconciler"
+	"code.uber.internal/pkg/reconciler/requeue"
+	"code.uber.internal/pkg/testutil/harness"
+	"go.uber.org/fx"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+// newReconciler starts the reconciler with the fakes of a harness holding the given objects
+func newReconciler(t *testing.T, objects ...runtime.Object) (*harness.Harness, reconciler.Interface) {
+	t.Helper()
+
+	h := harness.New(t, objects...)
+	var r reconciler.Interface
+	h.App(reconciler.Module, fx.Populate(&r))
+	h.Start()
+	return h, r
+}
+
+func TestReconcileCanceledEvictionRequest(t *testing.T) {
+	pod := harness.NewPod("default", "pod")
+	evictionRequest := harness.NewEvictionRequest(pod, "requester")
+	evictionRequest.Spec.Requesters = nil
+	h, r := newReconciler(t, pod, evictionRequest)
+
+	if err := r.ReconcileEvictionRequest(h.Context(), evictionRequest.DeepCopy()); err != nil {
+		t.Fatalf("ReconcileEvictionRequest() error = %v", err)
+	}
+
+	if _, err := h.KubeClient.CoreV1().Pods(pod.Namespace).Get(h.Context(), pod.Name, metav1.GetOptions{}); err != nil {
+		t.Errorf("pod of a canceled eviction request was evicted: %v", err)
+	}
+	got := h.EvictionRequest(evictionRequest.Namespace, evictionRequest.Name)
+	if meta.IsStatusConditionTrue(got.Status.Conditions, constants.ConditionTypeEvicted) {
+		t.Errorf("canceled eviction request has condition %s", constants.ConditionTypeEvicted)
+	}
+	if got.Status.EvictionRequestCancellationPolicy != "" {
+		t.Errorf("canceled eviction request was processed, status = %+v", got.Status)
+	}
+}
+
+func TestReconcileResumesWhenRequesterIsAdded(t *testing.T) {
+	pod := harness.NewPod("default", "pod")
+	evictionRequest := harness.NewEvictionRequest(pod, "requester")
+	evictionRequest.Spec.Requesters = nil
+	h, r := newReconciler(t, pod, evictionRequest)
+
+	if err := r.ReconcileEvictionRequest(h.Context(), evictionRequest.DeepCopy()); err != nil {
+		t.Fatalf("ReconcileEvictionRequest() error = %v", err)
+	}
+	evictionRequest.Spec.Requesters = []v1alpha1.Requester{{Name: "requester"}}
+	if err := r.ReconcileEvictionRequest(h.Context(), evictionRequest.DeepCopy()); err != nil {
+		t.Fatalf("ReconcileEvictionRequest() error = %v", err)
+	}
+
+	if _, err := h.KubeClient.CoreV1().Pods(pod.Namespace).Get(h.Context(), pod.Name, metav1.GetOptions{}); err == nil {
+		t.Errorf("pod was not evicted once a requester was added")
+	}
+	got := h.EvictionRequest(evictionRequest.Namespace, evictionRequest.Name)
+	if got.Status.EvictionRequestCancellationPolicy != v1alpha1.Allow {
+		t.Errorf("EvictionRequestCancellationPolicy = %q, expected %q", got.Status.EvictionRequestCancellationPolicy, v1alpha1.Allow)
+	}
+}
+
+// newReplacedTarget returns a pod and an eviction request targeting its predecessor with the same name
+func newReplacedTarget() (*corev1.Pod, *v1alpha1.EvictionRequest) {
+	pod := harness.NewPod("default", "pod")
+	evictionRequest := harness.NewEvictionRequest(pod, "requester")
+	pod.UID = "replacement-uid"
+	return pod, evictionRequest
+}
+
+func TestReconcileTargetReplaced(t *testing.T) {
+	pod, evictionRequest := newReplacedTarget()
+	h, r := newReconciler(t, pod, evictionRequest)
+
+	if err := r.ReconcileEvictionRequest(h.Context(), evictionRequest.DeepCopy()); err != nil {
+		t.Fatalf("ReconcileEvictionRequest() error = %v", err)
+	}
+
+	if _, err := h.KubeClient.CoreV1().Pods(pod.Namespace).Get(h.Context(), pod.Name, metav1.GetOptions{}); err != nil {
+		t.Errorf("replacement pod was evicted: %v", err)
+	}
+	got := h.EvictionRequest(evictionRequest.Namespace, evictionRequest.Name)
+	complete := meta.FindStatusCondition(got.Status.Conditions, string(v1alpha1.EvictionRequestComplete))
+	if complete == nil || complete.Status != metav1.ConditionTrue || complete.Reason != constants.ReasonTargetReplaced {
+		t.Errorf("Complete condition = %+v, expected reason %s", complete, constants.ReasonTargetReplaced)
+	}
+	if events := h.Events(); len(events) != 1 || !strings.HasPrefix(events[0], corev1.EventTypeWarning+" "+constants.ReasonTargetReplaced) {
+		t.Errorf("events = %v, expected a %s warning", events, constants.ReasonTargetReplaced)
+	}
+	evictionRequests, err := h.EvictionRequestClient.EvictionrequestV1alpha1().EvictionRequests(evictionRequest.Namespace).List(h.Context(), metav1.ListOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(evictionRequests.Items) != 1 {
+		t.Errorf("found %d eviction requests, expected no retargeted eviction request", len(evictionRequests.Items))
+	}
+}
+
+func TestReconcileTargetReplacedRetargetsByName(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		erName      string
+		terminating bool
+		retargeted  bool
+	}{
+		{name: "retargeted", erName: "pod", retargeted: true},
+		{name: "long name", erName: strings.Repeat("a", validation.DNS1123SubdomainMaxLength), retargeted: true},
+		{name: "terminating replacement", erName: "pod", terminating: true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			pod, evictionRequest := newReplacedTarget()
+			if tc.terminating {
+				pod.DeletionTimestamp = &metav1.Time{Time: metav1.Now().Time}
+				pod.Finalizers = []string{"example.com/finalizer"}
+			}
+			evictionRequest.Name = tc.erName
+			evictionRequest.Annotations = map[string]string{v1alpha1.RetargetByNameAnnotation: "true"}
+			h, r := newReconciler(t, pod, evictionRequest)
+
+			// Retried reconciles create the retargeted eviction request once
+			for range 2 {
+				if err := r.ReconcileEvictionRequest(h.Context(), evictionRequest.DeepCopy()); err != nil {
+					t.Fatalf("ReconcileEvictionRequest() error = %v", err)
+				}
+			}
+
+			evictionRequests, err := h.EvictionRequestClient.EvictionrequestV1alpha1().EvictionRequests(evictionRequest.Namespace).List(h.Context(), metav1.ListOptions{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			var retargeted []v1alpha1.EvictionRequest
+			for _, item := range evictionRequests.Items {
+				if item.UID != evictionRequest.UID {
+					retargeted = append(retargeted, item)
+				}
+			}
+			if !tc.retargeted {
+				if len(retargeted) != 0 {
+					t.Errorf("retargeted eviction requests = %v, expected none for a terminating pod", retargeted)
+				}
+				return
+			}
+
+			if len(retargeted) != 1 {
+				t.Fatalf("found %d retargeted eviction requests, expected 1", len(retargeted))
+			}
+			got := retargeted[0]
+			if len(got.Name) > validation.DNS1123SubdomainMaxLength {
+				t.Errorf("retargeted eviction request name has %d characters", len(got.Name))
+			}
+			if got.Spec.Target.PodRef.UID != string(pod.UID) || got.Annotations[v1alpha1.RetargetByNameAnnotation] != "true" {
+				t.Errorf("retargeted eviction request = %+v, expected to target %s with the annotation", got.ObjectMeta, pod.UID)
+			}
+			if len(got.Spec.Requesters) != 1 || got.Spec.Requesters[0].Name != "requester" {
+				t.Errorf("retargeted requesters = %v, expected the requesters of the original", got.Spec.Requesters)
+			}
+			original := h.EvictionRequest(evictionRequest.Namespace, evictionRequest.Name)
+			complete := meta.FindStatusCondition(original.Status.Conditions, string(v1alpha1.EvictionRequestComplete))
+			if complete == nil || !strings.Contains(complete.Message, got.Name) {
+				t.Errorf("Complete condition = %+v, expected to name %s", complete, got.Name)
+			}
+		})
+	}
+}
+
+func TestReconcileTargetReplacedAlreadyComplete(t *testing.T) {
+	pod, evictionRequest := newReplacedTarget()
+	evictionRequest.Annotations = map[string]string{v1alpha1.RetargetByNameAnnotation: "true"}
+	meta.SetStatusCondition(&evictionRequest.Status.Conditions, metav1.Condition{
+		Type:   string(v1alpha1.EvictionRequestComplete),
+		Status: metav1.ConditionTrue,
+		Reason: constants.ReasonPodNotFound,
+	})
+	h, r := newReconciler(t, pod, evictionRequest)
+
+	if err := r.ReconcileEvictionRequest(h.Context(), evictionRequest.DeepCopy()); err != nil {
+		t.Fatalf("ReconcileEvictionRequest() error = %v", err)
+	}
+
+	if events := h.Events(); len(events) != 0 {
+		t.Errorf("events = %v, expected none for a complete eviction request", events)
+	}
+	got := h.EvictionRequest(evictionRequest.Namespace, evictionRequest.Name)
+	if complete := meta.FindStatusCondition(got.Status.Conditions, string(v1alpha1.EvictionRequestComplete)); complete.Reason != constants.ReasonPodNotFound {
+		t.Errorf("Complete condition reason = %s, expected %s to be kept", complete.Reason, constants.ReasonPodNotFound)
+	}
+	evictionRequests, err := h.EvictionRequestClient.EvictionrequestV1alpha1().EvictionRequests(evictionRequest.Namespace).List(h.Context(), metav1.ListOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(evictionRequests.Items) != 1 {
+		t.Errorf("found %d eviction requests, expected no retargeted eviction request", len(evictionRequests.Items))
+	}
+}
+
+func TestReconcilePodNotFound(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		// exists keeps the pod in the API server while it is missing from the pod cache
+		exists      bool
+		expectDelay bool
+	}{
+		{name: "pod deleted"},
+		{name: "pod not in the cache yet", exists: true, expectDelay: true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			pod := harness.NewPod("default", "pod")
+			evictionRequest := harness.NewEvictionRequest(pod, "requester")
+			h, r := newReconciler(t, pod, evictionRequest)
+			if err := h.KubeInformerFactory.Core().V1().Pods().Informer().GetStore().Delete(pod); err != nil {
+				t.Fatal(err)
+			}
+			if !tc.exists {
+				if err := h.KubeClient.Tracker().Delete(corev1.SchemeGroupVersion.WithResource("pods"), pod.Namespace, pod.Name); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			err := r.ReconcileEvictionRequest(h.Context(), evictionRequest.DeepCopy())
+			if _, delayed := requeue.Is(err); delayed != tc.expectDelay || (err != nil && !delayed) {
+				t.Fatalf("ReconcileEvictionRequest() error = %v, expected a requeue %v", err, tc.expectDelay)
+			}
+			got := h.EvictionRequest(evictionRequest.Namespace, evictionRequest.Name)
+			if complete := meta.IsStatusConditionTrue(got.Status.Conditions, string(v1alpha1.EvictionRequestComplete)); complete == tc.exists {
+				t.Errorf("eviction request complete = %v, expected %v", complete, !tc.exists)
+			}
+		})
+	}
+}
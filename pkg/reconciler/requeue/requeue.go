@@ -0,0 +1,32 @@
+package requeue
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// Error is returned by the reconciler to process an EvictionRequest again after a delay.
+// Unlike other errors it does not indicate a failure, so the worker does not apply its backoff.
+type Error struct {
+	// After is the delay before the EvictionRequest is reconciled again
+	After time.Duration
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("requeue after %s", e.After)
+}
+
+// After returns an Error requeueing the EvictionRequest after the given delay
+func After(after time.Duration) error {
+	return &Error{After: after}
+}
+
+// Is reports whether err asks for a requeue, and returns the delay if so
+func Is(err error) (time.Duration, bool) {
+	var requeueErr *Error
+	if errors.As(err, &requeueErr) {
+		return requeueErr.After, true
+	}
+	return 0, false
+}
@@ -9,6 +9,7 @@ import (
 	"go.uber.org/fx"
 	"go.uber.org/zap"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/clock"
 )
 
 type Interface interface {
@@ -19,12 +20,14 @@ type Interface interface {
 type statusHandler struct {
 	EvictionRequestClient versioned.Interface
 	Logger                *zap.Logger
+	Clock                 clock.Clock
 }
 
 func New(params Params) Interface {
 	return &statusHandler{
 		EvictionRequestClient: params.EvictionRequestClient,
 		Logger:                params.Logger,
+		Clock:                 params.Clock,
 	}
 }
 
@@ -34,11 +37,12 @@ type Params struct {
 
 	EvictionRequestClient versioned.Interface
 	Logger                *zap.Logger
+	Clock                 clock.Clock
 }
 
 // UpsertCondition adds or updates a condition in the eviction request status
 func (s *statusHandler) UpsertCondition(ctx context.Context, evictionRequest *v1alpha1.EvictionRequest, conditionType string, status metav1.ConditionStatus, reason, message string) error {
-	now := metav1.Now()
+	now := metav1.NewTime(s.Clock.Now())
 	condition := metav1.Condition{
 		Type:               conditionType,
 		Status:             status,
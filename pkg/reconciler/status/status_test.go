@@ -0,0 +1,126 @@
+package status
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"code.uber.internal/apis/evictionrequest/v1alpha1"
+	"code.uber.internal/pkg/constants"
+	evreqfake "code.uber.internal/pkg/generated/clientset/versioned/fake"
+	"go.uber.org/zap"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8stesting "k8s.io/client-go/testing"
+	clocktesting "k8s.io/utils/clock/testing"
+)
+
+var _now = time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func newTestHandler(evictionRequest *v1alpha1.EvictionRequest) (*statusHandler, *evreqfake.Clientset, *clocktesting.FakeClock) {
+	client := evreqfake.NewSimpleClientset(evictionRequest.DeepCopy())
+	fakeClock := clocktesting.NewFakeClock(_now)
+	return &statusHandler{EvictionRequestClient: client, Logger: zap.NewNop(), Clock: fakeClock}, client, fakeClock
+}
+
+func newEvictionRequest() *v1alpha1.EvictionRequest {
+	return &v1alpha1.EvictionRequest{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "pod"}}
+}
+
+func get(t *testing.T, client *evreqfake.Clientset, evictionRequest *v1alpha1.EvictionRequest) *v1alpha1.EvictionRequest {
+	t.Helper()
+
+	got, err := client.EvictionrequestV1alpha1().EvictionRequests(evictionRequest.Namespace).Get(context.Background(), evictionRequest.Name, metav1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return got
+}
+
+func TestUpsertCondition(t *testing.T) {
+	evictionRequest := newEvictionRequest()
+	handler, client, fakeClock := newTestHandler(evictionRequest)
+	ctx := context.Background()
+
+	if err := handler.UpsertCondition(ctx, evictionRequest, constants.ConditionTypeEvicted, metav1.ConditionFalse, constants.ReasonEvictionFailed, "failed"); err != nil {
+		t.Fatalf("UpsertCondition() error = %v", err)
+	}
+	got := get(t, client, evictionRequest)
+	condition := meta.FindStatusCondition(got.Status.Conditions, constants.ConditionTypeEvicted)
+	if condition == nil || condition.Status != metav1.ConditionFalse || !condition.LastTransitionTime.Time.Equal(_now) {
+		t.Fatalf("Evicted condition = %+v, expected false since %v", condition, _now)
+	}
+	if got.Status.EvictionRequestCancellationPolicy != v1alpha1.Allow {
+		t.Errorf("EvictionRequestCancellationPolicy = %q, expected the default %q", got.Status.EvictionRequestCancellationPolicy, v1alpha1.Allow)
+	}
+
+	// The transition time is kept while the status does not change
+	fakeClock.Step(time.Minute)
+	if err := handler.UpsertCondition(ctx, evictionRequest, constants.ConditionTypeEvicted, metav1.ConditionFalse, constants.ReasonEvictionFailed, "failed again"); err != nil {
+		t.Fatalf("UpsertCondition() error = %v", err)
+	}
+	condition = meta.FindStatusCondition(get(t, client, evictionRequest).Status.Conditions, constants.ConditionTypeEvicted)
+	if condition.Message != "failed again" || !condition.LastTransitionTime.Time.Equal(_now) {
+		t.Errorf("Evicted condition = %+v, expected the new message since %v", condition, _now)
+	}
+
+	fakeClock.Step(time.Minute)
+	if err := handler.UpsertCondition(ctx, evictionRequest, constants.ConditionTypeEvicted, metav1.ConditionTrue, constants.ReasonEvictionSucceeded, "evicted"); err != nil {
+		t.Fatalf("UpsertCondition() error = %v", err)
+	}
+	got = get(t, client, evictionRequest)
+	condition = meta.FindStatusCondition(got.Status.Conditions, constants.ConditionTypeEvicted)
+	if condition.Status != metav1.ConditionTrue || !condition.LastTransitionTime.Time.Equal(fakeClock.Now()) {
+		t.Errorf("Evicted condition = %+v, expected true since %v", condition, fakeClock.Now())
+	}
+	if len(got.Status.Conditions) != 1 {
+		t.Errorf("conditions = %+v, expected a single Evicted condition", got.Status.Conditions)
+	}
+}
+
+func TestUpsertConditionForbidIsKept(t *testing.T) {
+	evictionRequest := newEvictionRequest()
+	evictionRequest.Status.EvictionRequestCancellationPolicy = v1alpha1.Forbid
+	handler, client, _ := newTestHandler(evictionRequest)
+
+	if err := handler.UpsertCondition(context.Background(), evictionRequest, constants.ConditionTypeEvicted, metav1.ConditionTrue, constants.ReasonEvictionSucceeded, "evicted"); err != nil {
+		t.Fatalf("UpsertCondition() error = %v", err)
+	}
+	if policy := get(t, client, evictionRequest).Status.EvictionRequestCancellationPolicy; policy != v1alpha1.Forbid {
+		t.Errorf("EvictionRequestCancellationPolicy = %q, expected %q", policy, v1alpha1.Forbid)
+	}
+}
+
+func TestUpsertConditionUpdateError(t *testing.T) {
+	evictionRequest := newEvictionRequest()
+	handler, client, _ := newTestHandler(evictionRequest)
+	client.PrependReactor("update", "evictionrequests", func(k8stesting.Action) (bool, runtime.Object, error) {
+		return true, nil, errors.New("conflict")
+	})
+
+	if err := handler.UpsertCondition(context.Background(), evictionRequest, constants.ConditionTypeEvicted, metav1.ConditionTrue, constants.ReasonEvictionSucceeded, "evicted"); err == nil {
+		t.Error("UpsertCondition() succeeded, expected the update error")
+	}
+}
+
+func TestIncrementFailedEvictionCounter(t *testing.T) {
+	evictionRequest := newEvictionRequest()
+	handler, client, _ := newTestHandler(evictionRequest)
+
+	for range 2 {
+		if err := handler.IncrementFailedEvictionCounter(context.Background(), evictionRequest); err != nil {
+			t.Fatalf("IncrementFailedEvictionCounter() error = %v", err)
+		}
+	}
+
+	got := get(t, client, evictionRequest)
+	if got.Status.PodEvictionStatus == nil || got.Status.PodEvictionStatus.FailedAPIEvictionCounter != 2 {
+		t.Errorf("pod eviction status = %+v, expected 2 failed evictions", got.Status.PodEvictionStatus)
+	}
+	condition := meta.FindStatusCondition(got.Status.Conditions, constants.ConditionTypeEvicted)
+	if condition == nil || condition.Status != metav1.ConditionFalse || condition.Reason != constants.ReasonEvictionFailed {
+		t.Errorf("Evicted condition = %+v, expected reason %s", condition, constants.ReasonEvictionFailed)
+	}
+}
@@ -0,0 +1,134 @@
+// Package window holds back evictions until the EvictionSchedules selecting the pod allow them.
+package window
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"code.uber.internal/apis/evictionrequest/v1alpha1"
+	"code.uber.internal/pkg/constants"
+	"code.uber.internal/pkg/evictionschedule"
+	evreqlisters "code.uber.internal/pkg/generated/listers/evictionrequest/v1alpha1"
+	"code.uber.internal/pkg/reconciler/requeue"
+	"code.uber.internal/pkg/reconciler/status"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	corev1listers "k8s.io/client-go/listers/core/v1"
+	"k8s.io/utils/clock"
+)
+
+// _maxWait is the longest an eviction request waits before its schedules are evaluated again,
+// so changes to the schedules are picked up
+const _maxWait = 5 * time.Minute
+
+type Interface interface {
+	// Wait returns a requeue error while the eviction schedules selecting the pod hold back its eviction,
+	// and keeps the WaitingForEvictionWindow condition of the eviction request up to date
+	Wait(ctx context.Context, evictionRequest *v1alpha1.EvictionRequest, pod *corev1.Pod) error
+}
+
+type window struct {
+	EvictionScheduleLister evreqlisters.EvictionScheduleLister
+	NamespaceLister        corev1listers.NamespaceLister
+	StatusHandler          status.Interface
+	Logger                 *zap.Logger
+	Clock                  clock.Clock
+}
+
+func New(params params) Interface {
+	return &window{
+		EvictionScheduleLister: params.EvictionScheduleLister,
+		NamespaceLister:        params.NamespaceLister,
+		StatusHandler:          params.StatusHandler,
+		Logger:                 params.Logger,
+		Clock:                  params.Clock,
+	}
+}
+
+type params struct {
+	fx.In
+
+	EvictionScheduleLister evreqlisters.EvictionScheduleLister
+	NamespaceLister        corev1listers.NamespaceLister
+	StatusHandler          status.Interface
+	Logger                 *zap.Logger
+	Clock                  clock.Clock
+}
+
+// Wait evaluates the eviction schedules selecting the pod
+func (w *window) Wait(ctx context.Context, evictionRequest *v1alpha1.EvictionRequest, pod *corev1.Pod) error {
+	schedules, err := w.matchingSchedules(pod)
+	if err != nil {
+		return err
+	}
+
+	now := w.Clock.Now()
+	result := evictionschedule.Evaluate(schedules, now)
+	waiting := meta.FindStatusCondition(evictionRequest.Status.Conditions, constants.ConditionTypeWaitingForEvictionWindow)
+
+	if result.Allowed {
+		// Persisted with the next status update
+		if waiting != nil && waiting.Status == metav1.ConditionTrue {
+			meta.SetStatusCondition(&evictionRequest.Status.Conditions, metav1.Condition{
+				Type:               constants.ConditionTypeWaitingForEvictionWindow,
+				Status:             metav1.ConditionFalse,
+				LastTransitionTime: metav1.NewTime(now),
+				Reason:             constants.ReasonEvictionWindowOpen,
+				Message:            "Eviction schedules allow the eviction",
+			})
+		}
+		return nil
+	}
+
+	w.Logger.Info("Waiting for eviction window",
+		zap.String("target_pod_name", pod.Name),
+		zap.String("reason", result.Reason),
+		zap.String("message", result.Message))
+
+	if waiting == nil || waiting.Status != metav1.ConditionTrue || waiting.Reason != result.Reason || waiting.Message != result.Message {
+		if err := w.StatusHandler.UpsertCondition(ctx, evictionRequest, constants.ConditionTypeWaitingForEvictionWindow, metav1.ConditionTrue, result.Reason, result.Message); err != nil {
+			return fmt.Errorf("failed to update eviction window condition: %w", err)
+		}
+	}
+
+	after := _maxWait
+	if !result.Until.IsZero() && result.Until.Sub(now) < after {
+		after = result.Until.Sub(now)
+	}
+	return requeue.After(after)
+}
+
+// matchingSchedules returns the eviction schedules selecting the pod
+func (w *window) matchingSchedules(pod *corev1.Pod) ([]*v1alpha1.EvictionSchedule, error) {
+	schedules, err := w.EvictionScheduleLister.List(labels.Everything())
+	if err != nil {
+		return nil, fmt.Errorf("failed to list eviction schedules: %w", err)
+	}
+	if len(schedules) == 0 {
+		return nil, nil
+	}
+
+	namespace, err := w.NamespaceLister.Get(pod.Namespace)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get namespace: %w", err)
+	}
+
+	var matching []*v1alpha1.EvictionSchedule
+	for _, schedule := range schedules {
+		matches, err := evictionschedule.Matches(schedule, namespace, pod)
+		if err != nil {
+			w.Logger.Warn("Invalid selector in eviction schedule, applying it to all pods",
+				zap.String("eviction_schedule", schedule.Name), zap.Error(err))
+			matches = true
+		}
+		if matches {
+			matching = append(matching, schedule)
+		}
+	}
+	return matching, nil
+}
@@ -0,0 +1,52 @@
+package shadow
+
+import (
+	"code.uber.internal/pkg/config"
+	"code.uber.internal/pkg/generated/clientset/versioned"
+	"go.uber.org/fx"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+)
+
+type clientParams struct {
+	fx.In
+
+	Options               config.Options
+	Config                *rest.Config
+	Shadow                Interface
+	KubeClient            kubernetes.Interface
+	EvictionRequestClient versioned.Interface
+}
+
+type clientResult struct {
+	fx.Out
+
+	KubeClient            kubernetes.Interface
+	EvictionRequestClient versioned.Interface
+}
+
+// DecorateClients replaces the clients with clients sending their writes as dry runs in shadow mode
+func DecorateClients(params clientParams) (clientResult, error) {
+	if !params.Options.Shadow {
+		return clientResult{
+			KubeClient:            params.KubeClient,
+			EvictionRequestClient: params.EvictionRequestClient,
+		}, nil
+	}
+
+	dryRunConfig := rest.CopyConfig(params.Config)
+	dryRunConfig.Wrap(params.Shadow.WrapTransport)
+
+	evictionRequestClient, err := config.NewForConfigEvictionRequestClientFn(dryRunConfig)
+	if err != nil {
+		return clientResult{}, err
+	}
+	kubeClient, err := config.NewForConfigKubeClientFn(dryRunConfig)
+	if err != nil {
+		return clientResult{}, err
+	}
+	return clientResult{
+		KubeClient:            kubeClient,
+		EvictionRequestClient: evictionRequestClient,
+	}, nil
+}
@@ -0,0 +1,141 @@
+// Package shadow runs the controller alongside the production one without acting. Its writes are sent as dry
+// runs, and the status it would have written is compared with the status the production controller writes
+// from the same state of the eviction request.
+package shadow
+
+import (
+	"expvar"
+	"net/http"
+	"reflect"
+	"sort"
+	"sync"
+
+	"code.uber.internal/apis/evictionrequest/v1alpha1"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+	"k8s.io/client-go/tools/cache"
+)
+
+var (
+	// _dryRunWrites counts the writes sent as dry runs
+	_dryRunWrites = expvar.NewInt("evictionrequest_shadow_dry_run_writes_total")
+	// _matched counts the decisions of the shadow that the production controller took as well
+	_matched = expvar.NewInt("evictionrequest_shadow_decisions_matched_total")
+	// _diverged counts the decisions of the shadow that differ from the ones of the production controller
+	_diverged = expvar.NewInt("evictionrequest_shadow_decisions_diverged_total")
+)
+
+type Interface interface {
+	// WrapTransport makes the writes sent through a transport dry runs
+	WrapTransport(rt http.RoundTripper) http.RoundTripper
+	// Observe compares an update of an eviction request with the status the shadow would have written over
+	// the same resource version. It does nothing unless the shadow mode is enabled.
+	Observe(oldEvictionRequest, newEvictionRequest *v1alpha1.EvictionRequest)
+	// Forget drops the status the shadow would have written to a deleted eviction request
+	Forget(evictionRequest *v1alpha1.EvictionRequest)
+}
+
+type shadow struct {
+	logger *zap.Logger
+
+	mu sync.Mutex
+	// pending holds the last status the shadow would have written, by eviction request key
+	pending map[string]write
+}
+
+// write is a status the shadow would have written over a resource version of an eviction request
+type write struct {
+	resourceVersion string
+	status          v1alpha1.EvictionRequestStatus
+}
+
+type params struct {
+	fx.In
+
+	Logger *zap.Logger
+}
+
+// New creates the shadow of the controller
+func New(params params) Interface {
+	return &shadow{
+		logger:  params.Logger,
+		pending: make(map[string]write),
+	}
+}
+
+// record keeps the status the shadow would have written over a resource version of an eviction request
+func (s *shadow) record(evictionRequest *v1alpha1.EvictionRequest) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.pending[cache.MetaObjectToName(evictionRequest).String()] = write{
+		resourceVersion: evictionRequest.ResourceVersion,
+		status:          evictionRequest.Status,
+	}
+}
+
+// Observe compares the decisions of an update made over the resource version the shadow wrote over with the
+// decisions of the shadow. Updates that take no decision, e.g. heartbeats of interceptors, are not compared.
+func (s *shadow) Observe(oldEvictionRequest, newEvictionRequest *v1alpha1.EvictionRequest) {
+	if oldEvictionRequest.ResourceVersion == newEvictionRequest.ResourceVersion {
+		return
+	}
+
+	key := cache.MetaObjectToName(newEvictionRequest).String()
+	s.mu.Lock()
+	pending, ok := s.pending[key]
+	ok = ok && pending.resourceVersion == oldEvictionRequest.ResourceVersion
+	if ok {
+		delete(s.pending, key)
+	}
+	s.mu.Unlock()
+	if !ok {
+		return
+	}
+
+	previous := decisionsOf(&oldEvictionRequest.Status)
+	production := decisionsOf(&newEvictionRequest.Status)
+	if reflect.DeepEqual(previous, production) {
+		return
+	}
+	shadowed := decisionsOf(&pending.status)
+	if reflect.DeepEqual(shadowed, production) {
+		_matched.Add(1)
+		return
+	}
+	_diverged.Add(1)
+	s.logger.Info("Shadow decision diverged from the production controller",
+		zap.String("eviction_request", key),
+		zap.String("resource_version", pending.resourceVersion),
+		zap.Any("shadow", shadowed),
+		zap.Any("production", production))
+}
+
+// Forget drops the status the shadow would have written to a deleted eviction request
+func (s *shadow) Forget(evictionRequest *v1alpha1.EvictionRequest) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.pending, cache.MetaObjectToName(evictionRequest).String())
+}
+
+// decisions are the fields of the status the controller decides on. Times, messages and the fields written
+// by interceptors are left out.
+type decisions struct {
+	ActiveInterceptorClass string   `json:"activeInterceptorClass,omitempty"`
+	Interceptors           []string `json:"interceptors,omitempty"`
+	Conditions             []string `json:"conditions,omitempty"`
+}
+
+func decisionsOf(status *v1alpha1.EvictionRequestStatus) decisions {
+	var d decisions
+	if status.ActiveInterceptorClass != nil {
+		d.ActiveInterceptorClass = *status.ActiveInterceptorClass
+	}
+	for _, entry := range status.InterceptorHistory {
+		d.Interceptors = append(d.Interceptors, entry.InterceptorClass+"="+string(entry.Reason))
+	}
+	for _, condition := range status.Conditions {
+		d.Conditions = append(d.Conditions, condition.Type+"="+string(condition.Status)+"/"+condition.Reason)
+	}
+	sort.Strings(d.Conditions)
+	return d
+}
@@ -0,0 +1,130 @@
+package shadow
+
+import (
+	"reflect"
+	"testing"
+
+	"code.uber.internal/apis/evictionrequest/v1alpha1"
+	"go.uber.org/zap"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+// newEvictionRequest returns an eviction request at a resource version with an active interceptor
+func newEvictionRequest(resourceVersion, activeInterceptorClass string) *v1alpha1.EvictionRequest {
+	evictionRequest := &v1alpha1.EvictionRequest{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "pod", ResourceVersion: resourceVersion},
+	}
+	if activeInterceptorClass != "" {
+		evictionRequest.Status.ActiveInterceptorClass = ptr.To(activeInterceptorClass)
+	}
+	return evictionRequest
+}
+
+func TestObserve(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		// shadow is the eviction request the shadow would have written, if any
+		shadow           *v1alpha1.EvictionRequest
+		forget           bool
+		old, new         *v1alpha1.EvictionRequest
+		expectedMatched  int64
+		expectedDiverged int64
+		expectedPending  bool
+	}{
+		{
+			name:            "matched",
+			shadow:          newEvictionRequest("1", "high.example.com"),
+			old:             newEvictionRequest("1", ""),
+			new:             newEvictionRequest("2", "high.example.com"),
+			expectedMatched: 1,
+		},
+		{
+			name:             "diverged",
+			shadow:           newEvictionRequest("1", "low.example.com"),
+			old:              newEvictionRequest("1", ""),
+			new:              newEvictionRequest("2", "high.example.com"),
+			expectedDiverged: 1,
+		},
+		{
+			name:   "update without decision",
+			shadow: newEvictionRequest("1", "low.example.com"),
+			old:    newEvictionRequest("1", "high.example.com"),
+			new:    newEvictionRequest("2", "high.example.com"),
+		},
+		{
+			name:            "update over another resource version",
+			shadow:          newEvictionRequest("1", "low.example.com"),
+			old:             newEvictionRequest("2", ""),
+			new:             newEvictionRequest("3", "high.example.com"),
+			expectedPending: true,
+		},
+		{
+			name:            "resync",
+			shadow:          newEvictionRequest("1", "low.example.com"),
+			old:             newEvictionRequest("1", ""),
+			new:             newEvictionRequest("1", ""),
+			expectedPending: true,
+		},
+		{
+			name: "no shadow write",
+			old:  newEvictionRequest("1", ""),
+			new:  newEvictionRequest("2", "high.example.com"),
+		},
+		{
+			name:   "deleted",
+			shadow: newEvictionRequest("1", "low.example.com"),
+			forget: true,
+			old:    newEvictionRequest("1", ""),
+			new:    newEvictionRequest("2", "high.example.com"),
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			s := New(params{Logger: zap.NewNop()}).(*shadow)
+			if tc.shadow != nil {
+				s.record(tc.shadow)
+			}
+			if tc.forget {
+				s.Forget(tc.shadow)
+			}
+			matched, diverged := _matched.Value(), _diverged.Value()
+
+			s.Observe(tc.old, tc.new)
+
+			if got := _matched.Value() - matched; got != tc.expectedMatched {
+				t.Errorf("matched decisions = %d, expected %d", got, tc.expectedMatched)
+			}
+			if got := _diverged.Value() - diverged; got != tc.expectedDiverged {
+				t.Errorf("diverged decisions = %d, expected %d", got, tc.expectedDiverged)
+			}
+			if _, pending := s.pending["default/pod"]; pending != tc.expectedPending {
+				t.Errorf("shadow write pending = %v, expected %v", pending, tc.expectedPending)
+			}
+		})
+	}
+}
+
+func TestDecisionsIgnoreTimesAndConditionOrder(t *testing.T) {
+	now := metav1.Now()
+	shadowed := v1alpha1.EvictionRequestStatus{
+		InterceptorHistory: []v1alpha1.InterceptorHistoryEntry{
+			{InterceptorClass: "high.example.com", SelectionTime: now, Reason: v1alpha1.InterceptorCompleted},
+		},
+		Conditions: []metav1.Condition{
+			{Type: "Evicted", Status: metav1.ConditionTrue, Reason: "EvictionSucceeded", Message: "shadow"},
+			{Type: "Complete", Status: metav1.ConditionFalse, Reason: "Pending"},
+		},
+	}
+	production := v1alpha1.EvictionRequestStatus{
+		InterceptorHistory: []v1alpha1.InterceptorHistoryEntry{
+			{InterceptorClass: "high.example.com", SelectionTime: metav1.NewTime(now.Add(1)), Reason: v1alpha1.InterceptorCompleted},
+		},
+		Conditions: []metav1.Condition{
+			{Type: "Complete", Status: metav1.ConditionFalse, Reason: "Pending"},
+			{Type: "Evicted", Status: metav1.ConditionTrue, Reason: "EvictionSucceeded", Message: "production"},
+		},
+	}
+	if got, expected := decisionsOf(&shadowed), decisionsOf(&production); !reflect.DeepEqual(got, expected) {
+		t.Errorf("decisionsOf() = %+v, expected %+v", got, expected)
+	}
+}
@@ -0,0 +1,88 @@
+package shadow
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+
+	"code.uber.internal/apis/evictionrequest/v1alpha1"
+	"go.uber.org/zap"
+)
+
+const (
+	// _leasesPrefix is the API path of leases: the shadow holds its own leader election lease for real
+	_leasesPrefix = "/apis/coordination.k8s.io/"
+	// _authenticationPrefix is the API path of authentication reviews, which do not write anything
+	_authenticationPrefix = "/apis/authentication.k8s.io/"
+)
+
+// _evictionRequestsPrefix is the API path of the eviction request group
+var _evictionRequestsPrefix = "/apis/" + v1alpha1.GroupVersion.Group + "/"
+
+// dryRunTransport sends the writes of the controller with dryRun=All and records the status it would have
+// written to eviction requests
+type dryRunTransport struct {
+	next   http.RoundTripper
+	shadow *shadow
+}
+
+// WrapTransport makes the writes sent through a transport dry runs
+func (s *shadow) WrapTransport(rt http.RoundTripper) http.RoundTripper {
+	return &dryRunTransport{next: rt, shadow: s}
+}
+
+func (t *dryRunTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return t.next.RoundTrip(req)
+	}
+	if strings.HasPrefix(req.URL.Path, _leasesPrefix) || strings.HasPrefix(req.URL.Path, _authenticationPrefix) {
+		return t.next.RoundTrip(req)
+	}
+
+	req = req.Clone(req.Context())
+	query := req.URL.Query()
+	query.Set("dryRun", "All")
+	req.URL.RawQuery = query.Encode()
+
+	_dryRunWrites.Add(1)
+	t.shadow.logger.Debug("Sending write as dry run", zap.String("method", req.Method), zap.String("path", req.URL.Path))
+	if req.Method == http.MethodPut && isEvictionRequestStatus(req.URL.Path) {
+		if err := t.recordStatus(req); err != nil {
+			t.shadow.logger.Warn("Failed to record shadow status write", zap.String("path", req.URL.Path), zap.Error(err))
+		}
+	}
+	return t.next.RoundTrip(req)
+}
+
+// recordStatus records the eviction request of a status update without consuming the request body
+func (t *dryRunTransport) recordStatus(req *http.Request) error {
+	if req.Body == nil {
+		return nil
+	}
+	data, err := io.ReadAll(req.Body)
+	_ = req.Body.Close()
+	req.Body = io.NopCloser(bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+
+	evictionRequest := &v1alpha1.EvictionRequest{}
+	if err := json.Unmarshal(data, evictionRequest); err != nil {
+		return err
+	}
+	t.shadow.record(evictionRequest)
+	return nil
+}
+
+// isEvictionRequestStatus reports whether an API path is the status of an eviction request, i.e.
+// /apis/<group>/<version>/namespaces/<namespace>/evictionrequests/<name>/status
+func isEvictionRequestStatus(path string) bool {
+	if !strings.HasPrefix(path, _evictionRequestsPrefix) {
+		return false
+	}
+	segments := strings.Split(strings.TrimPrefix(path, _evictionRequestsPrefix), "/")
+	return len(segments) == 6 && segments[1] == "namespaces" && segments[3] == "evictionrequests" && segments[5] == "status"
+}
@@ -0,0 +1,185 @@
+package shadow
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"code.uber.internal/apis/evictionrequest/v1alpha1"
+	"code.uber.internal/pkg/config"
+	"go.uber.org/zap"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/rest"
+	"k8s.io/utils/ptr"
+)
+
+// request is a request received by the API server of a test
+type request struct {
+	method string
+	path   string
+	dryRun string
+}
+
+// apiServer echoes the objects written to it and records the requests it receives
+type apiServer struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	requests []request
+}
+
+func newAPIServer(t *testing.T) *apiServer {
+	t.Helper()
+
+	s := &apiServer{}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		s.requests = append(s.requests, request{method: r.Method, path: r.URL.Path, dryRun: r.URL.Query().Get("dryRun")})
+		s.mu.Unlock()
+
+		body, _ := io.ReadAll(r.Body)
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method == http.MethodGet {
+			body = []byte(`{"apiVersion":"v1","kind":"Pod","metadata":{"namespace":"default","name":"pod"}}`)
+		}
+		_, _ = w.Write(body)
+	}))
+	t.Cleanup(s.Close)
+	return s
+}
+
+// lastRequest returns the last request received by the server
+func (s *apiServer) lastRequest(t *testing.T) request {
+	t.Helper()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.requests) == 0 {
+		t.Fatal("the API server received no request")
+	}
+	return s.requests[len(s.requests)-1]
+}
+
+func TestDecorateClientsSendsWritesAsDryRuns(t *testing.T) {
+	server := newAPIServer(t)
+	s := New(params{Logger: zap.NewNop()}).(*shadow)
+	clients, err := DecorateClients(clientParams{
+		Options: config.Options{Shadow: true},
+		// The server echoes the objects written to it, so the clients must not send protobuf
+		Config: &rest.Config{Host: server.URL, ContentConfig: rest.ContentConfig{ContentType: "application/json"}},
+		Shadow: s,
+	})
+	if err != nil {
+		t.Fatalf("DecorateClients() error = %v", err)
+	}
+	ctx := context.Background()
+	writesBefore := _dryRunWrites.Value()
+
+	evictionRequest := &v1alpha1.EvictionRequest{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "pod", ResourceVersion: "7"},
+		Status:     v1alpha1.EvictionRequestStatus{ActiveInterceptorClass: ptr.To("interceptor.example.com")},
+	}
+	for _, tc := range []struct {
+		name           string
+		write          func() error
+		expectedMethod string
+		expectedPath   string
+		expectedDryRun string
+	}{
+		{
+			name: "get",
+			write: func() error {
+				_, err := clients.KubeClient.CoreV1().Pods("default").Get(ctx, "pod", metav1.GetOptions{})
+				return err
+			},
+			expectedMethod: http.MethodGet,
+			expectedPath:   "/api/v1/namespaces/default/pods/pod",
+		},
+		{
+			name: "eviction request status",
+			write: func() error {
+				_, err := clients.EvictionRequestClient.EvictionrequestV1alpha1().EvictionRequests("default").
+					UpdateStatus(ctx, evictionRequest, metav1.UpdateOptions{})
+				return err
+			},
+			expectedMethod: http.MethodPut,
+			expectedPath:   "/apis/evictionrequest.coordination.uber.com/v1alpha1/namespaces/default/evictionrequests/pod/status",
+			expectedDryRun: "All",
+		},
+		{
+			name: "pod eviction",
+			write: func() error {
+				return clients.KubeClient.CoreV1().Pods("default").EvictV1(ctx, &policyv1.Eviction{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "pod"},
+				})
+			},
+			expectedMethod: http.MethodPost,
+			expectedPath:   "/api/v1/namespaces/default/pods/pod/eviction",
+			expectedDryRun: "All",
+		},
+		{
+			name: "lease",
+			write: func() error {
+				_, err := clients.KubeClient.CoordinationV1().Leases("default").Update(ctx, &coordinationv1.Lease{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "controller-shadow"},
+				}, metav1.UpdateOptions{})
+				return err
+			},
+			expectedMethod: http.MethodPut,
+			expectedPath:   "/apis/coordination.k8s.io/v1/namespaces/default/leases/controller-shadow",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			if err := tc.write(); err != nil {
+				t.Fatalf("request error = %v", err)
+			}
+			got := server.lastRequest(t)
+			if got.method != tc.expectedMethod || got.path != tc.expectedPath || got.dryRun != tc.expectedDryRun {
+				t.Errorf("request = %+v, expected %s %s with dryRun %q", got, tc.expectedMethod, tc.expectedPath, tc.expectedDryRun)
+			}
+		})
+	}
+
+	if writes := _dryRunWrites.Value() - writesBefore; writes != 2 {
+		t.Errorf("dry run writes = %d, expected 2", writes)
+	}
+	pending, ok := s.pending["default/pod"]
+	if !ok || pending.resourceVersion != "7" || *pending.status.ActiveInterceptorClass != "interceptor.example.com" {
+		t.Errorf("recorded status write = %+v, expected the status sent over resource version 7", pending)
+	}
+}
+
+func TestDecorateClientsWithoutShadowMode(t *testing.T) {
+	kubeClient := fake.NewSimpleClientset()
+	clients, err := DecorateClients(clientParams{
+		Config:     &rest.Config{Host: "https://unused"},
+		Shadow:     New(params{Logger: zap.NewNop()}),
+		KubeClient: kubeClient,
+	})
+	if err != nil {
+		t.Fatalf("DecorateClients() error = %v", err)
+	}
+	if clients.KubeClient != kubeClient {
+		t.Error("DecorateClients() replaced the clients outside shadow mode")
+	}
+}
+
+func TestIsEvictionRequestStatus(t *testing.T) {
+	for path, expected := range map[string]bool{
+		"/apis/evictionrequest.coordination.uber.com/v1alpha1/namespaces/default/evictionrequests/pod/status": true,
+		"/apis/evictionrequest.coordination.uber.com/v1beta1/namespaces/default/evictionrequests/pod/status":  true,
+		"/apis/evictionrequest.coordination.uber.com/v1alpha1/namespaces/default/evictionrequests/pod":        false,
+		"/apis/evictionrequest.coordination.uber.com/v1alpha1/namespaces/default/interceptorclasses/a/status": false,
+		"/api/v1/namespaces/default/pods/pod/status":                                                          false,
+	} {
+		if got := isEvictionRequestStatus(path); got != expected {
+			t.Errorf("isEvictionRequestStatus(%q) = %v, expected %v", path, got, expected)
+		}
+	}
+}
@@ -0,0 +1,189 @@
+// Package crdvalidation evaluates the CEL validation rules (x-kubernetes-validations) of a CRD manifest
+// against objects, for tests of the rules generated from the XValidation markers of the API types.
+//
+// It follows the semantics of the API server for the rules of the CRDs of this repository: a rule is evaluated
+// where its value is set, and a transition rule, i.e. a rule using oldSelf, only on updates where the old object
+// sets the value as well. Items of lists are not correlated with the old object, so transition rules below a
+// list are not evaluated.
+package crdvalidation
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/google/cel-go/cel"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/yaml"
+)
+
+// _reservedNames are the CEL keywords and reserved words that the API server escapes as __<name>__ when they
+// name a property
+var _reservedNames = map[string]bool{
+	"true": true, "false": true, "null": true, "in": true, "as": true, "break": true, "const": true,
+	"continue": true, "else": true, "for": true, "function": true, "if": true, "import": true, "let": true,
+	"loop": true, "package": true, "namespace": true, "return": true, "var": true, "void": true, "while": true,
+}
+
+// Validator evaluates the validation rules of a version of a CRD
+type Validator struct {
+	env    *cel.Env
+	schema *schema
+}
+
+// crd is the part of a CRD manifest holding the schemas of its versions
+type crd struct {
+	Spec struct {
+		Versions []struct {
+			Name   string `json:"name"`
+			Schema struct {
+				OpenAPIV3Schema *schema `json:"openAPIV3Schema"`
+			} `json:"schema"`
+		} `json:"versions"`
+	} `json:"spec"`
+}
+
+// schema is the part of an OpenAPI schema holding validation rules
+type schema struct {
+	Properties map[string]*schema `json:"properties,omitempty"`
+	Items      *schema            `json:"items,omitempty"`
+	Rules      []rule             `json:"x-kubernetes-validations,omitempty"`
+}
+
+type rule struct {
+	Rule    string `json:"rule"`
+	Message string `json:"message,omitempty"`
+}
+
+// New loads the schema of a version from a CRD manifest
+func New(path, version string) (*Validator, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var manifest crd
+	if err := yaml.Unmarshal(data, &manifest); err != nil {
+		return nil, fmt.Errorf("failed to parse CRD %s: %w", path, err)
+	}
+	env, err := cel.NewEnv(cel.Variable("self", cel.DynType), cel.Variable("oldSelf", cel.DynType))
+	if err != nil {
+		return nil, err
+	}
+	for _, v := range manifest.Spec.Versions {
+		if v.Name == version && v.Schema.OpenAPIV3Schema != nil {
+			return &Validator{env: env, schema: v.Schema.OpenAPIV3Schema}, nil
+		}
+	}
+	return nil, fmt.Errorf("CRD %s has no schema for version %s", path, version)
+}
+
+// Validate returns the sorted messages of the rules that the object fails, prefixed with the path of the value.
+// The old object is nil on creation.
+func (v *Validator) Validate(oldObj, obj runtime.Object) ([]string, error) {
+	newValue, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return nil, err
+	}
+	var oldValue map[string]any
+	if oldObj != nil {
+		if oldValue, err = runtime.DefaultUnstructuredConverter.ToUnstructured(oldObj); err != nil {
+			return nil, err
+		}
+	}
+	var errs []string
+	if err := v.validate("", v.schema, escape(oldValue), escape(newValue), oldObj != nil, &errs); err != nil {
+		return nil, err
+	}
+	sort.Strings(errs)
+	return errs, nil
+}
+
+func (v *Validator) validate(path string, s *schema, oldValue, value any, correlated bool, errs *[]string) error {
+	for _, r := range s.Rules {
+		ast, issues := v.env.Compile(r.Rule)
+		if issues.Err() != nil {
+			return fmt.Errorf("failed to compile rule %q of %s: %w", r.Rule, path, issues.Err())
+		}
+		if usesOldSelf(ast) && !correlated {
+			continue
+		}
+		program, err := v.env.Program(ast)
+		if err != nil {
+			return err
+		}
+		result, _, err := program.Eval(map[string]any{"self": value, "oldSelf": oldValue})
+		if err != nil {
+			return fmt.Errorf("failed to evaluate rule %q of %s: %w", r.Rule, path, err)
+		}
+		if valid, ok := result.Value().(bool); !ok || !valid {
+			message := r.Message
+			if message == "" {
+				message = "failed rule: " + r.Rule
+			}
+			*errs = append(*errs, path+": "+message)
+		}
+	}
+
+	switch value := value.(type) {
+	case map[string]any:
+		oldFields, _ := oldValue.(map[string]any)
+		for name, property := range s.Properties {
+			field, ok := value[escapeName(name)]
+			if !ok {
+				continue
+			}
+			oldField, oldOK := oldFields[escapeName(name)]
+			if err := v.validate(path+"."+name, property, oldField, field, correlated && oldOK, errs); err != nil {
+				return err
+			}
+		}
+	case []any:
+		if s.Items == nil {
+			return nil
+		}
+		for i, item := range value {
+			if err := v.validate(fmt.Sprintf("%s[%d]", path, i), s.Items, nil, item, false, errs); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// usesOldSelf reports whether a rule is a transition rule
+func usesOldSelf(ast *cel.Ast) bool {
+	for _, reference := range ast.NativeRep().ReferenceMap() {
+		if reference.Name == "oldSelf" {
+			return true
+		}
+	}
+	return false
+}
+
+// escape escapes the names of the properties of a value like the API server does before evaluating rules
+func escape(value any) any {
+	switch value := value.(type) {
+	case map[string]any:
+		escaped := make(map[string]any, len(value))
+		for name, field := range value {
+			escaped[escapeName(name)] = escape(field)
+		}
+		return escaped
+	case []any:
+		escaped := make([]any, len(value))
+		for i, item := range value {
+			escaped[i] = escape(item)
+		}
+		return escaped
+	default:
+		return value
+	}
+}
+
+func escapeName(name string) string {
+	if _reservedNames[name] {
+		return "__" + name + "__"
+	}
+	return strings.NewReplacer("__", "__underscores__", ".", "__dot__", "-", "__dash__", "/", "__slash__").Replace(name)
+}
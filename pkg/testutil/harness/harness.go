@@ -0,0 +1,301 @@
+// Package harness wires fake clientsets, informers and the fx graph of the eviction request controller for
+// unit and integration tests. Objects created through the fake clientsets are observed by the informers, so
+// tests can run the real worker pool and reconciler and assert on the resulting status writes and events.
+package harness
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"code.uber.internal/apis/evictionrequest/v1alpha1"
+	"code.uber.internal/pkg/config"
+	"code.uber.internal/pkg/generated/clientset/versioned"
+	evreqfake "code.uber.internal/pkg/generated/clientset/versioned/fake"
+	evreqscheme "code.uber.internal/pkg/generated/clientset/versioned/scheme"
+	evreqinformers "code.uber.internal/pkg/generated/informers/externalversions"
+	evreqlisters "code.uber.internal/pkg/generated/listers/evictionrequest/v1alpha1"
+	"code.uber.internal/pkg/informer"
+	"code.uber.internal/pkg/worker"
+	"go.uber.org/fx"
+	"go.uber.org/fx/fxtest"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+	corev1listers "k8s.io/client-go/listers/core/v1"
+	k8stesting "k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/clock"
+	clocktesting "k8s.io/utils/clock/testing"
+)
+
+const (
+	// _pollInterval is the interval at which Eventually checks its condition
+	_pollInterval = 10 * time.Millisecond
+	// _pollTimeout is the time after which Eventually fails the test
+	_pollTimeout = 10 * time.Second
+	// _recorderBufferSize is the number of events kept by the fake recorder
+	_recorderBufferSize = 100
+)
+
+// Harness holds the fakes injected into the controller components under test
+type Harness struct {
+	KubeClient            *kubefake.Clientset
+	EvictionRequestClient *evreqfake.Clientset
+
+	KubeInformerFactory            informers.SharedInformerFactory
+	EvictionRequestInformerFactory evreqinformers.SharedInformerFactory
+
+	Clock    *clocktesting.FakeClock
+	Recorder *record.FakeRecorder
+	Options  config.Options
+	Logger   *zap.Logger
+
+	tb     testing.TB
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	mu            sync.Mutex
+	evictionError error
+}
+
+// New creates a harness whose fake clientsets hold the given objects. Objects of the eviction request API
+// group go to the eviction request clientset, all others to the kube clientset. The harness is torn down
+// with the test.
+func New(tb testing.TB, objects ...runtime.Object) *Harness {
+	tb.Helper()
+
+	var kubeObjects, evictionRequestObjects []runtime.Object
+	for _, object := range objects {
+		if kinds, _, err := evreqscheme.Scheme.ObjectKinds(object); err == nil && kinds[0].Group == v1alpha1.GroupVersion.Group {
+			evictionRequestObjects = append(evictionRequestObjects, object)
+			continue
+		}
+		kubeObjects = append(kubeObjects, object)
+	}
+
+	options, err := config.NewOptions()
+	if err != nil {
+		tb.Fatalf("failed to read options: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	tb.Cleanup(cancel)
+
+	h := &Harness{
+		KubeClient:            kubefake.NewSimpleClientset(kubeObjects...),
+		EvictionRequestClient: evreqfake.NewSimpleClientset(evictionRequestObjects...),
+		Clock:                 clocktesting.NewFakeClock(time.Now()),
+		Recorder:              record.NewFakeRecorder(_recorderBufferSize),
+		Options:               options,
+		Logger:                zap.NewNop(),
+		tb:                    tb,
+		ctx:                   ctx,
+		cancel:                cancel,
+	}
+	h.KubeInformerFactory = informers.NewSharedInformerFactoryWithOptions(h.KubeClient, 0,
+		informers.WithTransform(informer.Trim),
+	)
+	h.EvictionRequestInformerFactory = evreqinformers.NewSharedInformerFactory(h.EvictionRequestClient, 0)
+	h.KubeClient.PrependReactor("create", "pods", h.evict)
+	return h
+}
+
+// Module provides the fakes of the harness to an fx graph, in place of config.NewClients, config.NewOptions,
+// events.New and the informer factories and listers of the main package
+func (h *Harness) Module() fx.Option {
+	return fx.Options(
+		fx.Supply(h.Options, h.Logger),
+		fx.Provide(
+			func() kubernetes.Interface { return h.KubeClient },
+			func() versioned.Interface { return h.EvictionRequestClient },
+			func() informers.SharedInformerFactory { return h.KubeInformerFactory },
+			func() evreqinformers.SharedInformerFactory { return h.EvictionRequestInformerFactory },
+			func() clock.Clock { return h.Clock },
+			func() record.EventRecorder { return h.Recorder },
+			func() corev1listers.PodLister { return h.KubeInformerFactory.Core().V1().Pods().Lister() },
+			func() corev1listers.NamespaceLister { return h.KubeInformerFactory.Core().V1().Namespaces().Lister() },
+			func() evreqlisters.EvictionRequestLister {
+				return h.EvictionRequestInformerFactory.Evictionrequest().V1alpha1().EvictionRequests().Lister()
+			},
+			func() evreqlisters.InterceptorClassLister {
+				return h.EvictionRequestInformerFactory.Evictionrequest().V1alpha1().InterceptorClasses().Lister()
+			},
+			func() evreqlisters.EvictionScheduleLister {
+				return h.EvictionRequestInformerFactory.Evictionrequest().V1alpha1().EvictionSchedules().Lister()
+			},
+			func() evreqlisters.EvictionPolicyLister {
+				return h.EvictionRequestInformerFactory.Evictionrequest().V1alpha1().EvictionPolicies().Lister()
+			},
+		),
+	)
+}
+
+// App starts an fx application with the fakes of the harness and the given options, e.g. reconciler.Module
+// and fx.Populate. It is stopped with the test.
+func (h *Harness) App(options ...fx.Option) *fxtest.App {
+	h.tb.Helper()
+
+	app := fxtest.New(h.tb, append([]fx.Option{h.Module()}, options...)...)
+	app.RequireStart()
+	h.tb.Cleanup(app.RequireStop)
+	return app
+}
+
+// Context returns the context of the harness, canceled with the test
+func (h *Harness) Context() context.Context {
+	return h.ctx
+}
+
+// Start starts the informers requested so far and waits for their caches to sync. Call it after App so
+// that the listers of the components under test are registered.
+func (h *Harness) Start() {
+	h.tb.Helper()
+
+	h.KubeInformerFactory.Start(h.ctx.Done())
+	h.EvictionRequestInformerFactory.Start(h.ctx.Done())
+	for informerType, synced := range h.KubeInformerFactory.WaitForCacheSync(h.ctx.Done()) {
+		if !synced {
+			h.tb.Fatalf("informer %v failed to sync", informerType)
+		}
+	}
+	for informerType, synced := range h.EvictionRequestInformerFactory.WaitForCacheSync(h.ctx.Done()) {
+		if !synced {
+			h.tb.Fatalf("informer %v failed to sync", informerType)
+		}
+	}
+}
+
+// RunWorker enqueues every added or updated eviction request into the worker pool, like the controller
+// does, starts the informers and runs the pool until the test ends
+func (h *Harness) RunWorker(w worker.Interface) {
+	h.tb.Helper()
+
+	_, err := h.EvictionRequestInformerFactory.Evictionrequest().V1alpha1().EvictionRequests().Informer().AddEventHandler(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    w.Enqueue,
+			UpdateFunc: func(_, obj interface{}) { w.Enqueue(obj) },
+		},
+	)
+	if err != nil {
+		h.tb.Fatalf("failed to add event handler: %v", err)
+	}
+	h.Start()
+	go w.Start(h.ctx)
+}
+
+// Step advances the fake clock
+func (h *Harness) Step(d time.Duration) {
+	h.Clock.Step(d)
+}
+
+// Eventually fails the test unless the condition holds within 10s
+func (h *Harness) Eventually(condition func() (bool, error)) {
+	h.tb.Helper()
+
+	err := wait.PollUntilContextTimeout(h.ctx, _pollInterval, _pollTimeout, true, func(context.Context) (bool, error) {
+		return condition()
+	})
+	if err != nil {
+		h.tb.Fatalf("condition not met: %v", err)
+	}
+}
+
+// EvictionRequest returns the eviction request as stored by the fake clientset, including status writes
+func (h *Harness) EvictionRequest(namespace, name string) *v1alpha1.EvictionRequest {
+	h.tb.Helper()
+
+	evictionRequest, err := h.EvictionRequestClient.EvictionrequestV1alpha1().EvictionRequests(namespace).Get(h.ctx, name, metav1.GetOptions{})
+	if err != nil {
+		h.tb.Fatalf("failed to get eviction request %s/%s: %v", namespace, name, err)
+	}
+	return evictionRequest
+}
+
+// Events returns the events recorded since the last call, formatted as "<type> <reason> <message>"
+func (h *Harness) Events() []string {
+	var events []string
+	for {
+		select {
+		case event := <-h.Recorder.Events:
+			events = append(events, event)
+		default:
+			return events
+		}
+	}
+}
+
+// FailEvictions makes the Eviction API return the given error, e.g. TooManyRequests for a pod disruption
+// budget or NotFound. A nil error restores successful evictions.
+func (h *Harness) FailEvictions(err error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.evictionError = err
+}
+
+// evict emulates the Eviction API: it deletes the pod unless FailEvictions set an error
+func (h *Harness) evict(action k8stesting.Action) (bool, runtime.Object, error) {
+	if action.GetSubresource() != "eviction" {
+		return false, nil, nil
+	}
+
+	h.mu.Lock()
+	err := h.evictionError
+	h.mu.Unlock()
+	if err != nil {
+		return true, nil, err
+	}
+
+	create, ok := action.(k8stesting.CreateAction)
+	if !ok {
+		return true, nil, errors.New("unexpected eviction action")
+	}
+	object, ok := create.GetObject().(metav1.Object)
+	if !ok {
+		return true, nil, errors.New("unexpected eviction object")
+	}
+	return true, nil, h.KubeClient.Tracker().Delete(corev1.SchemeGroupVersion.WithResource("pods"), action.GetNamespace(), object.GetName())
+}
+
+// NewPod returns a running pod with a UID derived from its name
+func NewPod(namespace, name string) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+			UID:       types.UID(name + "-uid"),
+		},
+		Status: corev1.PodStatus{Phase: corev1.PodRunning},
+	}
+}
+
+// NewEvictionRequest returns an eviction request for the pod with the given requester and interceptors
+func NewEvictionRequest(pod *corev1.Pod, requester string, interceptors ...v1alpha1.Interceptor) *v1alpha1.EvictionRequest {
+	heartbeatDeadlineSeconds := int32(1800)
+	return &v1alpha1.EvictionRequest{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              pod.Name,
+			Namespace:         pod.Namespace,
+			UID:               types.UID(pod.Name + "-evreq-uid"),
+			CreationTimestamp: metav1.Now(),
+		},
+		Spec: v1alpha1.EvictionRequestSpec{
+			Type: v1alpha1.Soft,
+			Target: v1alpha1.EvictionTarget{
+				PodRef: &v1alpha1.LocalPodReference{Name: pod.Name, UID: string(pod.UID)},
+			},
+			Requesters:               []v1alpha1.Requester{{Name: requester}},
+			Interceptors:             interceptors,
+			HeartbeatDeadlineSeconds: &heartbeatDeadlineSeconds,
+		},
+	}
+}
@@ -0,0 +1,68 @@
+package webhook
+
+import (
+	"context"
+	"time"
+
+	"code.uber.internal/apis/evictionrequest/v1alpha1"
+	"code.uber.internal/pkg/authorization"
+	"go.uber.org/zap"
+	admissionv1 "k8s.io/api/admission/v1"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+// _identityRetryInterval is the interval between attempts to resolve the identity of the controller
+const _identityRetryInterval = 5 * time.Second
+
+// resolveIdentity resolves the username of the controller with a SelfSubjectReview, retrying until it succeeds or
+// the server stops. The writes of the controller itself are not restricted by EvictionAuthorizationPolicies.
+func (s *server) resolveIdentity(stopCh <-chan struct{}) {
+	ctx := wait.ContextForChannel(stopCh)
+	_ = wait.PollUntilContextCancel(ctx, _identityRetryInterval, true, func(ctx context.Context) (bool, error) {
+		review, err := s.kubeClient.AuthenticationV1().SelfSubjectReviews().Create(ctx, &authenticationv1.SelfSubjectReview{}, metav1.CreateOptions{})
+		if err != nil {
+			s.logger.Warn("Failed to resolve the identity of the controller", zap.Error(err))
+			return false, nil
+		}
+		s.identity = review.Status.UserInfo.Username
+		s.logger.Info("Resolved the identity of the controller", zap.String("username", s.identity))
+		return true, nil
+	})
+}
+
+// validateAuthorization enforces the EvictionAuthorizationPolicies on a create or update of an eviction request:
+// only authorized users may add or remove requesters and write the status fields of the active interceptor. The
+// status fields written by the controller are reserved to it even when there are no policies.
+func (s *server) validateAuthorization(request *admissionv1.AdmissionRequest, evictionRequest, oldEvictionRequest *v1alpha1.EvictionRequest) field.ErrorList {
+	if s.identity != "" && request.UserInfo.Username == s.identity {
+		return nil
+	}
+
+	var fldPath *field.Path
+	switch {
+	case request.Operation == admissionv1.Create, request.SubResource == "":
+		fldPath = field.NewPath("spec", "requesters")
+	case request.SubResource == "status":
+		fldPath = field.NewPath("status")
+	default:
+		return nil
+	}
+
+	policies, err := s.authorizationPolicyLister.List(labels.Everything())
+	if err != nil {
+		return field.ErrorList{field.InternalError(fldPath, err)}
+	}
+
+	switch {
+	case request.Operation == admissionv1.Create:
+		return authorization.ValidateRequesters(policies, evictionRequest.Spec.Requesters, nil, request.UserInfo, fldPath)
+	case request.SubResource == "status":
+		return authorization.ValidateInterceptorStatus(policies, &evictionRequest.Status, &oldEvictionRequest.Status, request.UserInfo, fldPath)
+	default:
+		return authorization.ValidateRequesters(policies, evictionRequest.Spec.Requesters, oldEvictionRequest.Spec.Requesters, request.UserInfo, fldPath)
+	}
+}
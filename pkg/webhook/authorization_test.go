@@ -0,0 +1,62 @@
+package webhook
+
+import (
+	"testing"
+
+	"code.uber.internal/apis/evictionrequest/v1alpha1"
+	admissionv1 "k8s.io/api/admission/v1"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+func TestValidateAuthorizationWithoutPolicies(t *testing.T) {
+	oldEvictionRequest := &v1alpha1.EvictionRequest{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "pod"},
+		Status:     v1alpha1.EvictionRequestStatus{ActiveInterceptorClass: ptr.To("surge.example.com")},
+	}
+
+	for _, tc := range []struct {
+		name      string
+		user      string
+		update    func(status *v1alpha1.EvictionRequestStatus)
+		expectErr bool
+	}{
+		{
+			name: "interceptor status",
+			user: "mallory",
+			update: func(status *v1alpha1.EvictionRequestStatus) {
+				status.ActiveInterceptorCompleted = true
+			},
+		},
+		{
+			name: "active interceptor class",
+			user: "mallory",
+			update: func(status *v1alpha1.EvictionRequestStatus) {
+				status.ActiveInterceptorClass = ptr.To("other.example.com")
+			},
+			expectErr: true,
+		},
+		{
+			name: "active interceptor class by the controller",
+			user: "controller",
+			update: func(status *v1alpha1.EvictionRequestStatus) {
+				status.ActiveInterceptorClass = ptr.To("other.example.com")
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			evictionRequest := oldEvictionRequest.DeepCopy()
+			tc.update(&evictionRequest.Status)
+
+			allErrs := newTestServer(t, "controller").validateAuthorization(&admissionv1.AdmissionRequest{
+				Operation:   admissionv1.Update,
+				SubResource: "status",
+				UserInfo:    authenticationv1.UserInfo{Username: tc.user},
+			}, evictionRequest, oldEvictionRequest)
+			if (len(allErrs) != 0) != tc.expectErr {
+				t.Errorf("validateAuthorization() = %v, expected error %v", allErrs, tc.expectErr)
+			}
+		})
+	}
+}
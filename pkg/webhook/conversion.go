@@ -0,0 +1,123 @@
+package webhook
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"code.uber.internal/apis/evictionrequest/v1alpha1"
+	"code.uber.internal/apis/evictionrequest/v1beta1"
+	"go.uber.org/zap"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// _evictionRequestKind is the kind converted by the conversion webhook
+const _evictionRequestKind = "EvictionRequest"
+
+// conversionReview mirrors the apiextensions.k8s.io/v1 ConversionReview, whose Go types are not among the
+// dependencies of the controller
+type conversionReview struct {
+	metav1.TypeMeta `json:",inline"`
+	Request         *conversionRequest  `json:"request,omitempty"`
+	Response        *conversionResponse `json:"response,omitempty"`
+}
+
+// conversionRequest asks to convert objects to the desired API version
+type conversionRequest struct {
+	UID               types.UID              `json:"uid"`
+	DesiredAPIVersion string                 `json:"desiredAPIVersion"`
+	Objects           []runtime.RawExtension `json:"objects"`
+}
+
+// conversionResponse holds the converted objects in the order of the request, or the failure
+type conversionResponse struct {
+	UID              types.UID              `json:"uid"`
+	ConvertedObjects []runtime.RawExtension `json:"convertedObjects"`
+	Result           metav1.Status          `json:"result"`
+}
+
+// serveConversion answers ConversionReviews of EvictionRequests
+func (s *server) serveConversion(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(io.LimitReader(r.Body, _maxRequestBytes))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	review := &conversionReview{}
+	if err := json.Unmarshal(body, review); err != nil || review.Request == nil {
+		http.Error(w, "expected a ConversionReview with a request", http.StatusBadRequest)
+		return
+	}
+
+	response := &conversionResponse{
+		UID:    review.Request.UID,
+		Result: metav1.Status{Status: metav1.StatusSuccess},
+	}
+	for _, object := range review.Request.Objects {
+		converted, err := convertEvictionRequest(object.Raw, review.Request.DesiredAPIVersion)
+		if err != nil {
+			s.logger.Error("Failed to convert eviction request",
+				zap.String("desired_api_version", review.Request.DesiredAPIVersion), zap.Error(err))
+			response.ConvertedObjects = nil
+			response.Result = metav1.Status{Status: metav1.StatusFailure, Message: err.Error()}
+			break
+		}
+		response.ConvertedObjects = append(response.ConvertedObjects, runtime.RawExtension{Object: converted})
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(&conversionReview{
+		TypeMeta: review.TypeMeta,
+		Response: response,
+	}); err != nil {
+		s.logger.Error("Failed to write conversion response", zap.Error(err))
+	}
+}
+
+// convertEvictionRequest converts an EvictionRequest to the desired API version through the v1beta1 hub
+func convertEvictionRequest(raw []byte, desiredAPIVersion string) (runtime.Object, error) {
+	typeMeta := metav1.TypeMeta{}
+	if err := json.Unmarshal(raw, &typeMeta); err != nil {
+		return nil, err
+	}
+	if typeMeta.Kind != _evictionRequestKind {
+		return nil, fmt.Errorf("unsupported kind %q", typeMeta.Kind)
+	}
+
+	hub := &v1beta1.EvictionRequest{}
+	switch typeMeta.APIVersion {
+	case v1beta1.GroupVersion.String():
+		if err := json.Unmarshal(raw, hub); err != nil {
+			return nil, err
+		}
+	case v1alpha1.GroupVersion.String():
+		spoke := &v1alpha1.EvictionRequest{}
+		if err := json.Unmarshal(raw, spoke); err != nil {
+			return nil, err
+		}
+		if err := spoke.ConvertTo(hub); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("unsupported API version %q", typeMeta.APIVersion)
+	}
+
+	switch desiredAPIVersion {
+	case v1beta1.GroupVersion.String():
+		hub.TypeMeta = metav1.TypeMeta{APIVersion: desiredAPIVersion, Kind: _evictionRequestKind}
+		return hub, nil
+	case v1alpha1.GroupVersion.String():
+		spoke := &v1alpha1.EvictionRequest{}
+		if err := spoke.ConvertFrom(hub); err != nil {
+			return nil, err
+		}
+		spoke.TypeMeta = metav1.TypeMeta{APIVersion: desiredAPIVersion, Kind: _evictionRequestKind}
+		return spoke, nil
+	default:
+		return nil, fmt.Errorf("unsupported desired API version %q", desiredAPIVersion)
+	}
+}
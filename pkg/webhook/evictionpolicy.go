@@ -0,0 +1,32 @@
+package webhook
+
+import (
+	"encoding/json"
+
+	"code.uber.internal/apis/evictionrequest/v1alpha1"
+	"code.uber.internal/pkg/evictionpolicy"
+	admissionv1 "k8s.io/api/admission/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// validateEvictionPolicy rejects EvictionPolicies the controller cannot apply, e.g. with an invalid namespace selector
+func (s *server) validateEvictionPolicy(request *admissionv1.AdmissionRequest) *metav1.Status {
+	if request.Operation != admissionv1.Create && request.Operation != admissionv1.Update {
+		return nil
+	}
+
+	policy := &v1alpha1.EvictionPolicy{}
+	if err := json.Unmarshal(request.Object.Raw, policy); err != nil {
+		return badRequest(err)
+	}
+
+	allErrs := evictionpolicy.Validate(&policy.Spec, field.NewPath("spec"))
+	if len(allErrs) == 0 {
+		return nil
+	}
+
+	status := apierrors.NewInvalid(v1alpha1.GroupVersion.WithKind("EvictionPolicy").GroupKind(), policy.Name, allErrs).ErrStatus
+	return &status
+}
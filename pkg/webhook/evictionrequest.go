@@ -0,0 +1,147 @@
+package webhook
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+
+	"code.uber.internal/apis/evictionrequest/v1alpha1"
+	"code.uber.internal/pkg/duplicate"
+	"code.uber.internal/pkg/evictionpolicy"
+	"code.uber.internal/pkg/interceptorclass"
+	admissionv1 "k8s.io/api/admission/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// validateEvictionRequest enforces the rules of .spec.interceptors that depend on registered
+// InterceptorClasses, the constraints of the EvictionPolicies of the namespace and the
+// EvictionAuthorizationPolicies. The interceptors are immutable, so they are only checked on creation, along
+// with the uniqueness of the target pod.
+func (s *server) validateEvictionRequest(request *admissionv1.AdmissionRequest) *metav1.Status {
+	if request.Operation != admissionv1.Create && request.Operation != admissionv1.Update {
+		return nil
+	}
+
+	evictionRequest := &v1alpha1.EvictionRequest{}
+	if err := json.Unmarshal(request.Object.Raw, evictionRequest); err != nil {
+		return badRequest(err)
+	}
+	oldEvictionRequest := &v1alpha1.EvictionRequest{}
+	if request.Operation == admissionv1.Update {
+		if err := json.Unmarshal(request.OldObject.Raw, oldEvictionRequest); err != nil {
+			return badRequest(err)
+		}
+	}
+
+	policy, err := evictionpolicy.Resolve(s.evictionPolicyLister, s.namespaceLister, request.Namespace, s.logger)
+	if err != nil {
+		return internalError(err)
+	}
+
+	var allErrs field.ErrorList
+	switch {
+	case request.Operation == admissionv1.Create:
+		allErrs = append(allErrs, interceptorclass.ValidateInterceptors(evictionRequest.Spec.Interceptors, s.interceptorClassLister, field.NewPath("spec", "interceptors"))...)
+		allErrs = append(allErrs, evictionpolicy.ValidateHeartbeatDeadline(evictionRequest.Spec.HeartbeatDeadlineSeconds, policy, field.NewPath("spec", "heartbeatDeadlineSeconds"))...)
+		allErrs = append(allErrs, evictionpolicy.ValidateRequesters(evictionRequest.Spec.Requesters, nil, policy, field.NewPath("spec", "requesters"))...)
+		allErrs = append(allErrs, s.validateUniqueTarget(evictionRequest)...)
+	case request.SubResource == "status":
+		if evictionRequest.Status.EvictionRequestCancellationPolicy == v1alpha1.Forbid &&
+			oldEvictionRequest.Status.EvictionRequestCancellationPolicy != v1alpha1.Forbid &&
+			!evictionpolicy.ForbidAllowed(policy) {
+			allErrs = append(allErrs, field.Forbidden(field.NewPath("status", "evictionRequestCancellationPolicy"),
+				"Forbid is not allowed by the eviction policy of the namespace"))
+		}
+	case request.SubResource == "":
+		allErrs = append(allErrs, evictionpolicy.ValidateRequesters(evictionRequest.Spec.Requesters, oldEvictionRequest.Spec.Requesters, policy, field.NewPath("spec", "requesters"))...)
+	}
+	allErrs = append(allErrs, s.validateAuthorization(request, evictionRequest, oldEvictionRequest)...)
+	if len(allErrs) == 0 {
+		return nil
+	}
+
+	status := apierrors.NewInvalid(v1alpha1.GroupVersion.WithKind("EvictionRequest").GroupKind(), evictionRequest.Name, allErrs).ErrStatus
+	return &status
+}
+
+// validateUniqueTarget rejects a new eviction request for a pod that is already targeted by an active one
+func (s *server) validateUniqueTarget(evictionRequest *v1alpha1.EvictionRequest) field.ErrorList {
+	if !duplicate.IsActive(evictionRequest) {
+		return nil
+	}
+
+	evictionRequests, err := s.evictionRequestLister.EvictionRequests(evictionRequest.Namespace).List(labels.Everything())
+	if err != nil {
+		return field.ErrorList{field.InternalError(field.NewPath("spec", "target", "podRef", "uid"), err)}
+	}
+	existing := duplicate.Primary(duplicate.Active(evictionRequests, evictionRequest.Spec.Target.PodRef.UID))
+	if existing == nil {
+		return nil
+	}
+	return field.ErrorList{field.Forbidden(field.NewPath("spec", "target", "podRef", "uid"),
+		fmt.Sprintf("pod is already targeted by eviction request %s, add the requesters to its .spec.requesters instead", existing.Name))}
+}
+
+// mutateEvictionRequest applies the defaults of the EvictionPolicies of the namespace and of the registered
+// InterceptorClasses to new eviction requests
+func (s *server) mutateEvictionRequest(request *admissionv1.AdmissionRequest) ([]patchOperation, *metav1.Status) {
+	if request.Operation != admissionv1.Create {
+		return nil, nil
+	}
+
+	evictionRequest := &v1alpha1.EvictionRequest{}
+	if err := json.Unmarshal(request.Object.Raw, evictionRequest); err != nil {
+		return nil, badRequest(err)
+	}
+
+	policy, err := evictionpolicy.Resolve(s.evictionPolicyLister, s.namespaceLister, request.Namespace, s.logger)
+	if err != nil {
+		return nil, internalError(err)
+	}
+
+	var patch []patchOperation
+	interceptors := evictionRequest.Spec.Interceptors
+	policyInterceptors := len(interceptors) == 0 && len(policy.DefaultInterceptors) > 0
+	if policyInterceptors {
+		interceptors = slices.Clone(policy.DefaultInterceptors)
+	}
+	changed, err := interceptorclass.ApplyDefaults(interceptors, s.interceptorClassLister)
+	if err != nil {
+		return nil, internalError(err)
+	}
+	if policyInterceptors {
+		patch = append(patch, patchOperation{Op: "add", Path: "/spec/interceptors", Value: interceptors})
+	} else {
+		for _, idx := range changed {
+			patch = append(patch, patchOperation{Op: "replace", Path: fmt.Sprintf("/spec/interceptors/%d", idx), Value: interceptors[idx]})
+		}
+	}
+	heartbeatDeadlineSeconds := evictionRequest.Spec.HeartbeatDeadlineSeconds
+	if policy.DefaultHeartbeatDeadlineSeconds != nil &&
+		(heartbeatDeadlineSeconds == nil || *heartbeatDeadlineSeconds == evictionpolicy.APIDefaultHeartbeatDeadlineSeconds) {
+		patch = append(patch, patchOperation{Op: "add", Path: "/spec/heartbeatDeadlineSeconds", Value: *policy.DefaultHeartbeatDeadlineSeconds})
+	}
+	return patch, nil
+}
+
+func badRequest(err error) *metav1.Status {
+	return &metav1.Status{
+		Status:  metav1.StatusFailure,
+		Code:    http.StatusBadRequest,
+		Reason:  metav1.StatusReasonBadRequest,
+		Message: err.Error(),
+	}
+}
+
+func internalError(err error) *metav1.Status {
+	return &metav1.Status{
+		Status:  metav1.StatusFailure,
+		Code:    http.StatusInternalServerError,
+		Reason:  metav1.StatusReasonInternalError,
+		Message: err.Error(),
+	}
+}
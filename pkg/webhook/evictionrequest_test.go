@@ -0,0 +1,78 @@
+package webhook
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"code.uber.internal/apis/evictionrequest/v1alpha1"
+	evreqlisters "code.uber.internal/pkg/generated/listers/evictionrequest/v1alpha1"
+	admissionv1 "k8s.io/api/admission/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/utils/ptr"
+)
+
+func TestMutateEvictionRequestAppliesClassDefaults(t *testing.T) {
+	classes := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+	if err := classes.Add(&v1alpha1.InterceptorClass{
+		ObjectMeta: metav1.ObjectMeta{Name: "surge.example.com"},
+		Spec: v1alpha1.InterceptorClassSpec{
+			Domain:          "example.com",
+			DefaultPriority: ptr.To[int32](10000),
+			DefaultRole:     ptr.To("controller"),
+		},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	s := newTestServer(t, "controller")
+	s.interceptorClassLister = evreqlisters.NewInterceptorClassLister(classes)
+	s.evictionPolicyLister = evreqlisters.NewEvictionPolicyLister(cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{}))
+
+	for _, tc := range []struct {
+		name          string
+		interceptors  []v1alpha1.Interceptor
+		expectedPatch []patchOperation
+	}{
+		{
+			name:         "unset priority and role",
+			interceptors: []v1alpha1.Interceptor{{InterceptorClass: "other.example.com", Priority: 100}, {InterceptorClass: "surge.example.com"}},
+			expectedPatch: []patchOperation{{
+				Op:    "replace",
+				Path:  "/spec/interceptors/1",
+				Value: v1alpha1.Interceptor{InterceptorClass: "surge.example.com", Priority: 10000, Role: ptr.To("controller")},
+			}},
+		},
+		{
+			name:         "priority and role given",
+			interceptors: []v1alpha1.Interceptor{{InterceptorClass: "surge.example.com", Priority: 500, Role: ptr.To("other")}},
+		},
+		{
+			name:         "unregistered class",
+			interceptors: []v1alpha1.Interceptor{{InterceptorClass: "other.example.com"}},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			raw, err := json.Marshal(&v1alpha1.EvictionRequest{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "pod"},
+				Spec:       v1alpha1.EvictionRequestSpec{Interceptors: tc.interceptors},
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			patch, status := s.mutateEvictionRequest(&admissionv1.AdmissionRequest{
+				Operation: admissionv1.Create,
+				Namespace: "default",
+				Object:    runtime.RawExtension{Raw: raw},
+			})
+			if status != nil {
+				t.Fatalf("mutateEvictionRequest() status = %v", status)
+			}
+			if !reflect.DeepEqual(patch, tc.expectedPatch) {
+				t.Errorf("mutateEvictionRequest() = %+v, expected %+v", patch, tc.expectedPatch)
+			}
+		})
+	}
+}
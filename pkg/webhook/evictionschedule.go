@@ -0,0 +1,32 @@
+package webhook
+
+import (
+	"encoding/json"
+
+	"code.uber.internal/apis/evictionrequest/v1alpha1"
+	"code.uber.internal/pkg/evictionschedule"
+	admissionv1 "k8s.io/api/admission/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// validateEvictionSchedule rejects EvictionSchedules with schedules or time zones the controller cannot evaluate
+func (s *server) validateEvictionSchedule(request *admissionv1.AdmissionRequest) *metav1.Status {
+	if request.Operation != admissionv1.Create && request.Operation != admissionv1.Update {
+		return nil
+	}
+
+	schedule := &v1alpha1.EvictionSchedule{}
+	if err := json.Unmarshal(request.Object.Raw, schedule); err != nil {
+		return badRequest(err)
+	}
+
+	allErrs := evictionschedule.Validate(&schedule.Spec, field.NewPath("spec"))
+	if len(allErrs) == 0 {
+		return nil
+	}
+
+	status := apierrors.NewInvalid(v1alpha1.GroupVersion.WithKind("EvictionSchedule").GroupKind(), schedule.Name, allErrs).ErrStatus
+	return &status
+}
@@ -0,0 +1,46 @@
+package webhook
+
+import (
+	"encoding/json"
+
+	"code.uber.internal/pkg/authorization"
+	"code.uber.internal/pkg/heartbeat"
+	admissionv1 "k8s.io/api/admission/v1"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// validateLease enforces the EvictionAuthorizationPolicies on heartbeat Leases: only users authorized for an
+// interceptor class may hold a Lease in its name, since the controller counts the renewals as its heartbeats
+func (s *server) validateLease(request *admissionv1.AdmissionRequest) *metav1.Status {
+	if request.Operation != admissionv1.Create && request.Operation != admissionv1.Update {
+		return nil
+	}
+	if s.identity != "" && request.UserInfo.Username == s.identity {
+		return nil
+	}
+
+	lease := &coordinationv1.Lease{}
+	if err := json.Unmarshal(request.Object.Raw, lease); err != nil {
+		return badRequest(err)
+	}
+	if _, ok := lease.Labels[heartbeat.LeaseLabel]; !ok {
+		return nil
+	}
+
+	fldPath := field.NewPath("spec", "holderIdentity")
+	policies, err := s.authorizationPolicyLister.List(labels.Everything())
+	if err != nil {
+		return internalError(err)
+	}
+	allErrs := authorization.ValidateLeaseHolder(policies, lease.Spec.HolderIdentity, request.UserInfo, fldPath)
+	if len(allErrs) == 0 {
+		return nil
+	}
+
+	status := apierrors.NewInvalid(coordinationv1.SchemeGroupVersion.WithKind("Lease").GroupKind(), lease.Name, allErrs).ErrStatus
+	return &status
+}
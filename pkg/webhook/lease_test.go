@@ -0,0 +1,82 @@
+package webhook
+
+import (
+	"encoding/json"
+	"testing"
+
+	"code.uber.internal/apis/evictionrequest/v1alpha1"
+	evreqlisters "code.uber.internal/pkg/generated/listers/evictionrequest/v1alpha1"
+	"code.uber.internal/pkg/heartbeat"
+	"go.uber.org/zap"
+	admissionv1 "k8s.io/api/admission/v1"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/utils/ptr"
+)
+
+// newTestServer returns a webhook server of the given controller identity whose lister holds the policies
+func newTestServer(t *testing.T, identity string, policies ...*v1alpha1.EvictionAuthorizationPolicy) *server {
+	t.Helper()
+
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+	for _, policy := range policies {
+		if err := indexer.Add(policy); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return &server{
+		logger:                    zap.NewNop(),
+		authorizationPolicyLister: evreqlisters.NewEvictionAuthorizationPolicyLister(indexer),
+		identity:                  identity,
+	}
+}
+
+func TestValidateLease(t *testing.T) {
+	policy := &v1alpha1.EvictionAuthorizationPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "surge"},
+		Spec: v1alpha1.EvictionAuthorizationPolicySpec{
+			InterceptorClasses: []v1alpha1.InterceptorClassAuthorization{{
+				InterceptorClass: "surge.example.com",
+				Subjects:         []v1alpha1.Subject{{Kind: v1alpha1.UserSubject, Name: "surge"}},
+			}},
+		},
+	}
+
+	for _, tc := range []struct {
+		name      string
+		user      string
+		labeled   bool
+		expectErr bool
+	}{
+		{name: "authorized", user: "surge", labeled: true},
+		{name: "unauthorized", user: "mallory", labeled: true, expectErr: true},
+		{name: "controller", user: "controller", labeled: true},
+		{name: "not a heartbeat lease", user: "mallory"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			lease := &coordinationv1.Lease{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "evictionrequest-uid"},
+				Spec:       coordinationv1.LeaseSpec{HolderIdentity: ptr.To("surge.example.com")},
+			}
+			if tc.labeled {
+				lease.Labels = map[string]string{heartbeat.LeaseLabel: "true"}
+			}
+			raw, err := json.Marshal(lease)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			status := newTestServer(t, "controller", policy).validateLease(&admissionv1.AdmissionRequest{
+				Operation: admissionv1.Update,
+				UserInfo:  authenticationv1.UserInfo{Username: tc.user},
+				Object:    runtime.RawExtension{Raw: raw},
+			})
+			if (status != nil) != tc.expectErr {
+				t.Errorf("validateLease() = %v, expected error %v", status, tc.expectErr)
+			}
+		})
+	}
+}
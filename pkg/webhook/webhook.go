@@ -0,0 +1,250 @@
+// Package webhook serves the admission webhooks of the eviction request controller.
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"code.uber.internal/pkg/constants"
+	"code.uber.internal/pkg/generated/clientset/versioned"
+	evreqinformers "code.uber.internal/pkg/generated/informers/externalversions"
+	evreqlisters "code.uber.internal/pkg/generated/listers/evictionrequest/v1alpha1"
+	"go.uber.org/fx"
+	"go.uber.org/zap"
+	admissionv1 "k8s.io/api/admission/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+	corev1listers "k8s.io/client-go/listers/core/v1"
+)
+
+const (
+	// CertDirEnv is the environment variable holding the directory with the tls.crt and tls.key of the
+	// webhook server. The webhook server is disabled if it is not set.
+	CertDirEnv = "WEBHOOK_CERT_DIR"
+	// PortEnv is the environment variable holding the port of the webhook server. Defaults to 9443.
+	PortEnv = "WEBHOOK_PORT"
+
+	// ValidateEvictionRequestPath is the path of the EvictionRequest validating webhook
+	ValidateEvictionRequestPath = "/validate-evictionrequest"
+	// MutateEvictionRequestPath is the path of the EvictionRequest mutating webhook
+	MutateEvictionRequestPath = "/mutate-evictionrequest"
+	// ValidateEvictionSchedulePath is the path of the EvictionSchedule validating webhook
+	ValidateEvictionSchedulePath = "/validate-evictionschedule"
+	// ValidateEvictionPolicyPath is the path of the EvictionPolicy validating webhook
+	ValidateEvictionPolicyPath = "/validate-evictionpolicy"
+	// ValidateLeasePath is the path of the validating webhook of heartbeat Leases
+	ValidateLeasePath = "/validate-lease"
+	// ConvertEvictionRequestPath is the path of the EvictionRequest conversion webhook
+	ConvertEvictionRequestPath = "/convert-evictionrequest"
+
+	_defaultPort       = 9443
+	_maxRequestBytes   = 3 << 20
+	_readHeaderTimeout = 10 * time.Second
+)
+
+type Interface interface {
+	Start()
+}
+
+type server struct {
+	lc     fx.Lifecycle
+	logger *zap.Logger
+
+	certDir string
+	port    int
+
+	kubeClient             kubernetes.Interface
+	informerFactory        evreqinformers.SharedInformerFactory
+	kubeInformerFactory    informers.SharedInformerFactory
+	interceptorClassLister evreqlisters.InterceptorClassLister
+	evictionPolicyLister   evreqlisters.EvictionPolicyLister
+	evictionRequestLister  evreqlisters.EvictionRequestLister
+	namespaceLister        corev1listers.NamespaceLister
+
+	authorizationPolicyLister evreqlisters.EvictionAuthorizationPolicyLister
+	// identity is the username of the controller, resolved before the webhooks are served
+	identity string
+}
+
+type params struct {
+	fx.In
+
+	Lifecycle             fx.Lifecycle
+	EvictionRequestClient versioned.Interface
+	KubeClient            kubernetes.Interface
+	Logger                *zap.Logger
+}
+
+// New creates the webhook server from WEBHOOK_CERT_DIR and WEBHOOK_PORT.
+// The server runs on every replica, so it keeps its own informers instead of sharing the ones
+// that are only started by the leader.
+func New(params params) (Interface, error) {
+	port := _defaultPort
+	if value := os.Getenv(PortEnv); value != "" {
+		parsed, err := strconv.Atoi(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s: %w", PortEnv, err)
+		}
+		port = parsed
+	}
+
+	informerFactory := evreqinformers.NewSharedInformerFactoryWithOptions(params.EvictionRequestClient, constants.DefaultResyncInterval)
+	kubeInformerFactory := informers.NewSharedInformerFactoryWithOptions(params.KubeClient, constants.DefaultResyncInterval)
+	return &server{
+		lc:                     params.Lifecycle,
+		logger:                 params.Logger,
+		certDir:                os.Getenv(CertDirEnv),
+		port:                   port,
+		kubeClient:             params.KubeClient,
+		informerFactory:        informerFactory,
+		kubeInformerFactory:    kubeInformerFactory,
+		interceptorClassLister: informerFactory.Evictionrequest().V1alpha1().InterceptorClasses().Lister(),
+		evictionPolicyLister:   informerFactory.Evictionrequest().V1alpha1().EvictionPolicies().Lister(),
+		evictionRequestLister:  informerFactory.Evictionrequest().V1alpha1().EvictionRequests().Lister(),
+		namespaceLister:        kubeInformerFactory.Core().V1().Namespaces().Lister(),
+
+		authorizationPolicyLister: informerFactory.Evictionrequest().V1alpha1().EvictionAuthorizationPolicies().Lister(),
+	}, nil
+}
+
+// Start registers the fx lifecycle hooks serving the webhooks
+func (s *server) Start() {
+	if s.certDir == "" {
+		s.logger.Info("Webhook server disabled", zap.String("env", CertDirEnv))
+		return
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc(ValidateEvictionRequestPath, s.serveValidation(s.validateEvictionRequest))
+	mux.HandleFunc(MutateEvictionRequestPath, s.serveMutation(s.mutateEvictionRequest))
+	mux.HandleFunc(ValidateEvictionSchedulePath, s.serveValidation(s.validateEvictionSchedule))
+	mux.HandleFunc(ValidateEvictionPolicyPath, s.serveValidation(s.validateEvictionPolicy))
+	mux.HandleFunc(ValidateLeasePath, s.serveValidation(s.validateLease))
+	mux.HandleFunc(ConvertEvictionRequestPath, s.serveConversion)
+	httpServer := &http.Server{
+		Addr:              fmt.Sprintf(":%d", s.port),
+		Handler:           mux,
+		ReadHeaderTimeout: _readHeaderTimeout,
+	}
+	stopCh := make(chan struct{})
+
+	s.lc.Append(fx.Hook{
+		OnStart: func(ctx context.Context) error {
+			go func() {
+				s.informerFactory.Start(stopCh)
+				s.kubeInformerFactory.Start(stopCh)
+				s.informerFactory.WaitForCacheSync(stopCh)
+				s.kubeInformerFactory.WaitForCacheSync(stopCh)
+				s.resolveIdentity(stopCh)
+
+				s.logger.Info("Starting webhook server", zap.Int("port", s.port))
+				err := httpServer.ListenAndServeTLS(filepath.Join(s.certDir, "tls.crt"), filepath.Join(s.certDir, "tls.key"))
+				if err != nil && !errors.Is(err, http.ErrServerClosed) {
+					s.logger.Error("Webhook server failed", zap.Error(err))
+				}
+			}()
+			return nil
+		},
+		OnStop: func(ctx context.Context) error {
+			s.logger.Info("Stopping webhook server")
+			close(stopCh)
+			return httpServer.Shutdown(ctx)
+		},
+	})
+}
+
+// validateFunc validates an admission request and returns the status denying it, or nil to allow it
+type validateFunc func(request *admissionv1.AdmissionRequest) *metav1.Status
+
+// patchOperation is a JSON patch operation of a mutating admission response
+type patchOperation struct {
+	Op    string      `json:"op"`
+	Path  string      `json:"path"`
+	Value interface{} `json:"value,omitempty"`
+}
+
+// mutateFunc returns the patch to apply to an admission request, or the status denying it
+type mutateFunc func(request *admissionv1.AdmissionRequest) ([]patchOperation, *metav1.Status)
+
+// serveValidation answers AdmissionReviews with the result of validate
+func (s *server) serveValidation(validate validateFunc) http.HandlerFunc {
+	return s.serveAdmission(func(request *admissionv1.AdmissionRequest, response *admissionv1.AdmissionResponse) {
+		if status := validate(request); status != nil {
+			s.deny(request, response, status)
+		}
+	})
+}
+
+// serveMutation answers AdmissionReviews with the patch returned by mutate
+func (s *server) serveMutation(mutate mutateFunc) http.HandlerFunc {
+	return s.serveAdmission(func(request *admissionv1.AdmissionRequest, response *admissionv1.AdmissionResponse) {
+		patch, status := mutate(request)
+		if status != nil {
+			s.deny(request, response, status)
+			return
+		}
+		if len(patch) == 0 {
+			return
+		}
+
+		raw, err := json.Marshal(patch)
+		if err != nil {
+			s.deny(request, response, internalError(err))
+			return
+		}
+		patchType := admissionv1.PatchTypeJSONPatch
+		response.Patch = raw
+		response.PatchType = &patchType
+	})
+}
+
+// deny rejects the admission request with the given status
+func (s *server) deny(request *admissionv1.AdmissionRequest, response *admissionv1.AdmissionResponse, status *metav1.Status) {
+	s.logger.Info("Denied admission request",
+		zap.String("namespace", request.Namespace),
+		zap.String("name", request.Name),
+		zap.String("operation", string(request.Operation)),
+		zap.String("reason", status.Message))
+	response.Allowed = false
+	response.Result = status
+}
+
+// serveAdmission decodes AdmissionReviews and answers them with the response filled in by review
+func (s *server) serveAdmission(review func(request *admissionv1.AdmissionRequest, response *admissionv1.AdmissionResponse)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(io.LimitReader(r.Body, _maxRequestBytes))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		admissionReview := &admissionv1.AdmissionReview{}
+		if err := json.Unmarshal(body, admissionReview); err != nil || admissionReview.Request == nil {
+			http.Error(w, "expected an AdmissionReview with a request", http.StatusBadRequest)
+			return
+		}
+
+		response := &admissionv1.AdmissionResponse{
+			UID:     admissionReview.Request.UID,
+			Allowed: true,
+		}
+		review(admissionReview.Request, response)
+
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(&admissionv1.AdmissionReview{
+			TypeMeta: admissionReview.TypeMeta,
+			Response: response,
+		}); err != nil {
+			s.logger.Error("Failed to write admission response", zap.Error(err))
+		}
+	}
+}
@@ -5,12 +5,16 @@ import (
 	"fmt"
 
 	"code.uber.internal/apis/evictionrequest/v1alpha1"
+	evreqlisters "code.uber.internal/pkg/generated/listers/evictionrequest/v1alpha1"
 	"code.uber.internal/pkg/reconciler"
+	"code.uber.internal/pkg/reconciler/requeue"
 	"go.uber.org/fx"
 	"go.uber.org/zap"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/util/runtime"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/util/workqueue"
+	"k8s.io/utils/clock"
 )
 
 const (
@@ -20,33 +24,52 @@ const (
 type Interface interface {
 	Enqueue(obj interface{})
 	Start(ctx context.Context)
+	Process(ctx context.Context) bool
 	GetWorkqueue() workqueue.RateLimitingInterface
 }
 
 type pool struct {
-	workqueue  workqueue.RateLimitingInterface
-	reconciler reconciler.Interface
-	logger     *zap.Logger
+	workqueue             workqueue.RateLimitingInterface
+	reconciler            reconciler.Interface
+	evictionRequestLister evreqlisters.EvictionRequestLister
+	logger                *zap.Logger
 }
 
 type params struct {
 	fx.In
 
-	Reconciler reconciler.Interface
-	Logger     *zap.Logger
+	Reconciler            reconciler.Interface
+	EvictionRequestLister evreqlisters.EvictionRequestLister
+	Clock                 clock.Clock
+	Logger                *zap.Logger
+	// Queue replaces the work queue, e.g. by the simulator to schedule eviction requests in virtual time
+	Queue workqueue.RateLimitingInterface `optional:"true"`
 }
 
-// New creates a new worker pool
-func New(params params) Interface {
+// New creates a new worker pool. Its queue delays requeued eviction requests on the injected clock, which
+// must provide tickers.
+func New(params params) (Interface, error) {
+	queue := params.Queue
+	if queue == nil {
+		queueClock, ok := params.Clock.(clock.WithTicker)
+		if !ok {
+			return nil, fmt.Errorf("clock %T does not provide tickers", params.Clock)
+		}
+		queue = workqueue.NewTypedRateLimitingQueueWithConfig(
+			workqueue.DefaultTypedControllerRateLimiter[any](),
+			workqueue.TypedRateLimitingQueueConfig[any]{
+				Name:  "eviction-requests",
+				Clock: queueClock,
+			},
+		)
+	}
 
 	return &pool{
-		workqueue: workqueue.NewNamedRateLimitingQueue(
-			workqueue.DefaultControllerRateLimiter(),
-			"eviction-requests",
-		),
-		reconciler: params.Reconciler,
-		logger:     params.Logger,
-	}
+		workqueue:             queue,
+		reconciler:            params.Reconciler,
+		evictionRequestLister: params.EvictionRequestLister,
+		logger:                params.Logger,
+	}, nil
 }
 
 // Enqueue adds an eviction request to the work queue
@@ -69,7 +92,7 @@ func (p *pool) Enqueue(obj interface{}) {
 		zap.String("name", evictionRequest.Name),
 	)
 
-	p.workqueue.Add(evictionRequest.DeepCopy())
+	p.workqueue.Add(key)
 }
 
 // Start begins the worker pool with the specified number of workers
@@ -88,6 +111,12 @@ func (p *pool) Start(ctx context.Context) {
 	p.logger.Info("Shutting down worker pool")
 }
 
+// Process processes the next eviction request of the queue in the calling goroutine, waiting for one if the
+// queue is empty. It returns false once the queue is shut down.
+func (p *pool) Process(ctx context.Context) bool {
+	return p.processNextWorkItem(ctx, 0)
+}
+
 // runWorker is a long-running function that will continually call the
 // processNextWorkItem function in order to read and process a message on the workqueue.
 func (p *pool) runWorker(ctx context.Context, workerID int) {
@@ -107,24 +136,44 @@ func (p *pool) processNextWorkItem(ctx context.Context, workerID int) bool {
 	err := func(obj interface{}) error {
 		defer p.workqueue.Done(obj)
 
-		evictionRequest, ok := obj.(*v1alpha1.EvictionRequest)
+		key, ok := obj.(string)
 		if !ok {
 			// As the item in the workqueue is actually invalid, we call
 			// Forget here else we'd go into a loop of attempting to
 			// process a work item that is invalid.
 			p.workqueue.Forget(obj)
-			runtime.HandleError(fmt.Errorf("expected *v1alpha1.EvictionRequest in workqueue but got %#v", obj))
+			runtime.HandleError(fmt.Errorf("expected string key in workqueue but got %#v", obj))
 			return nil
 		}
 
-		key, err := cache.MetaNamespaceKeyFunc(evictionRequest)
+		namespace, name, err := cache.SplitMetaNamespaceKey(key)
 		if err != nil {
 			p.workqueue.Forget(obj)
-			runtime.HandleError(fmt.Errorf("error obtaining key for eviction request: %w", err))
+			runtime.HandleError(fmt.Errorf("invalid eviction request key %q: %w", key, err))
 			return nil
 		}
 
+		// Always reconcile the latest cached version, the item may have been queued long ago.
+		cached, err := p.evictionRequestLister.EvictionRequests(namespace).Get(name)
+		if apierrors.IsNotFound(err) {
+			p.workqueue.Forget(obj)
+			p.logger.Debug("Eviction request no longer exists, dropping", zap.String("key", key))
+			return nil
+		}
+		if err != nil {
+			p.workqueue.AddRateLimited(obj)
+			return fmt.Errorf("error getting '%s' from cache: %w, requeuing", key, err)
+		}
+		evictionRequest := cached.DeepCopy()
+
 		if err := p.syncHandler(ctx, evictionRequest, workerID); err != nil {
+			if after, ok := requeue.Is(err); ok {
+				// The reconciler is waiting for something, check again after the requested delay.
+				p.workqueue.Forget(obj)
+				p.workqueue.AddAfter(obj, after)
+				return nil
+			}
+
 			// Put the item back on the workqueue to handle any transient errors.
 			p.workqueue.AddRateLimited(obj)
 			return fmt.Errorf("error syncing '%s': %w, requeuing", key, err)
@@ -156,6 +205,15 @@ func (p *pool) syncHandler(ctx context.Context, evictionRequest *v1alpha1.Evicti
 	)
 
 	err := p.reconciler.ReconcileEvictionRequest(ctx, evictionRequest)
+	if after, ok := requeue.Is(err); ok {
+		p.logger.Info("Requeueing eviction request",
+			zap.String("namespace", evictionRequest.Namespace),
+			zap.String("name", evictionRequest.Name),
+			zap.Int("worker_id", workerID),
+			zap.Duration("after", after),
+		)
+		return err
+	}
 	if err != nil {
 		p.logger.Error("Failed to reconcile eviction request",
 			zap.String("namespace", evictionRequest.Namespace),
@@ -0,0 +1,100 @@
+package worker
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"code.uber.internal/apis/evictionrequest/v1alpha1"
+	evreqlisters "code.uber.internal/pkg/generated/listers/evictionrequest/v1alpha1"
+	"code.uber.internal/pkg/reconciler/requeue"
+	"go.uber.org/zap"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/utils/clock"
+	clocktesting "k8s.io/utils/clock/testing"
+)
+
+// requeueingReconciler asks to be called again after a delay on its first call
+type requeueingReconciler struct {
+	after time.Duration
+
+	mu    sync.Mutex
+	calls int
+}
+
+func (r *requeueingReconciler) ReconcileEvictionRequest(context.Context, *v1alpha1.EvictionRequest) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.calls++
+	if r.calls == 1 {
+		return requeue.After(r.after)
+	}
+	return nil
+}
+
+func (r *requeueingReconciler) count() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.calls
+}
+
+func TestRequeueAfterUsesClock(t *testing.T) {
+	evictionRequest := &v1alpha1.EvictionRequest{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "pod"}}
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+	if err := indexer.Add(evictionRequest); err != nil {
+		t.Fatal(err)
+	}
+	fakeClock := clocktesting.NewFakeClock(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+	r := &requeueingReconciler{after: time.Hour}
+
+	w, err := New(params{
+		Reconciler:            r,
+		EvictionRequestLister: evreqlisters.NewEvictionRequestLister(indexer),
+		Clock:                 fakeClock,
+		Logger:                zap.NewNop(),
+	})
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go w.Start(ctx)
+
+	w.Enqueue(evictionRequest)
+	waitForCalls(t, r, 1)
+
+	// The requeue waits on the fake clock, not the wall clock
+	time.Sleep(50 * time.Millisecond)
+	fakeClock.Step(time.Hour - time.Second)
+	time.Sleep(50 * time.Millisecond)
+	if calls := r.count(); calls != 1 {
+		t.Fatalf("reconciled %d times before the requeue delay passed, expected 1", calls)
+	}
+	fakeClock.Step(time.Second)
+	waitForCalls(t, r, 2)
+}
+
+func waitForCalls(t *testing.T, r *requeueingReconciler, calls int) {
+	t.Helper()
+
+	err := wait.PollUntilContextTimeout(context.Background(), 10*time.Millisecond, 10*time.Second, true, func(context.Context) (bool, error) {
+		return r.count() >= calls, nil
+	})
+	if err != nil {
+		t.Fatalf("reconciled %d times, expected %d", r.count(), calls)
+	}
+}
+
+func TestNewRequiresTickerClock(t *testing.T) {
+	if _, err := New(params{Clock: clockWithoutTicker{}, Logger: zap.NewNop()}); err == nil {
+		t.Error("New() succeeded with a clock without tickers")
+	}
+}
+
+// clockWithoutTicker hides the tickers of the fake clock
+type clockWithoutTicker struct {
+	clock.Clock
+}